@@ -0,0 +1,187 @@
+// Command claimdiff compares the test case results of two certsuite claim files.
+//
+// Usage:
+//
+//	claimdiff [-o text|json] [-tc tc1,tc2] <base claim> <other claim>
+//
+// A claim can be given as a claim.json file or as the report directory holding it. Claims of a
+// format other than the supported one are compared with a warning. The command exits with 0 when
+// the claims match, 1 when they differ and 2 on error.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-claim/pkg/claim"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+)
+
+const (
+	exitCodeSame      = 0
+	exitCodeDifferent = 1
+	exitCodeError     = 2
+
+	outputText = "text"
+	outputJSON = "json"
+)
+
+var errUsage = errors.New("usage: claimdiff [-o text|json] [-tc tc1,tc2] <base claim> <other claim>")
+
+// diffReport is the JSON output of the command.
+type diffReport struct {
+	Base        string                         `json:"base"`
+	Other       string                         `json:"other"`
+	Differences []globalhelper.ClaimDifference `json:"differences"`
+}
+
+func main() {
+	exitCode, err := run(os.Args[1:], os.Stdout, os.Stderr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+
+	os.Exit(exitCode)
+}
+
+func run(args []string, stdout, stderr io.Writer) (int, error) {
+	flags := flag.NewFlagSet("claimdiff", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	output := flags.String("o", outputText, "output format, text or json")
+	tcNames := flags.String("tc", "", "comma separated test cases to compare, all of them by default")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return exitCodeError, err
+	}
+
+	if flags.NArg() != 2 || (*output != outputText && *output != outputJSON) {
+		return exitCodeError, errUsage
+	}
+
+	baseClaim, err := openClaim(flags.Arg(0), stderr)
+	if err != nil {
+		return exitCodeError, err
+	}
+
+	otherClaim, err := openClaim(flags.Arg(1), stderr)
+	if err != nil {
+		return exitCodeError, err
+	}
+
+	differences := globalhelper.DiffClaims(baseClaim, otherClaim, splitTestCases(*tcNames)...)
+
+	if *output == outputJSON {
+		err = writeJSON(stdout, diffReport{Base: flags.Arg(0), Other: flags.Arg(1), Differences: differences})
+	} else {
+		err = writeText(stdout, differences)
+	}
+
+	if err != nil {
+		return exitCodeError, err
+	}
+
+	if len(differences) > 0 {
+		return exitCodeDifferent, nil
+	}
+
+	return exitCodeSame, nil
+}
+
+// openClaim opens a claim file, or the claim file of a report directory, and writes a warning to
+// stderr when its format is not the supported one.
+func openClaim(claimPath string, stderr io.Writer) (*claim.Root, error) {
+	info, err := os.Stat(claimPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open claim %s: %w", claimPath, err)
+	}
+
+	if info.IsDir() {
+		claimPath = filepath.Join(claimPath, globalparameters.DefaultClaimFileName)
+	}
+
+	claimRoot, validation, err := globalhelper.OpenClaimFileOfAnyFormat(claimPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if validation.FormatProblem != "" {
+		fmt.Fprintf(stderr, "warning: %s: %s, supported %s\n", claimPath, validation.FormatProblem,
+			globalparameters.ClaimFormatVersion)
+	}
+
+	return claimRoot, nil
+}
+
+func splitTestCases(tcNames string) []string {
+	var names []string
+
+	for _, name := range strings.Split(tcNames, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+func writeJSON(writer io.Writer, report diffReport) error {
+	if report.Differences == nil {
+		report.Differences = []globalhelper.ClaimDifference{}
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
+
+func writeText(writer io.Writer, differences []globalhelper.ClaimDifference) error {
+	if len(differences) == 0 {
+		_, err := fmt.Fprintln(writer, "claims match")
+
+		return err
+	}
+
+	for _, difference := range differences {
+		_, err := fmt.Fprintln(writer, formatDifference(difference))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func formatDifference(difference globalhelper.ClaimDifference) string {
+	switch difference.Field {
+	case globalhelper.ClaimDiffFieldPresence:
+		if len(difference.Base) > 0 && difference.Base[0] == "true" {
+			return fmt.Sprintf("- %s: removed", difference.TestCase)
+		}
+
+		return fmt.Sprintf("+ %s: added", difference.TestCase)
+	case globalhelper.ClaimDiffFieldState, globalhelper.ClaimDiffFieldSkipReason:
+		return fmt.Sprintf("~ %s: %s %q -> %q", difference.TestCase, difference.Field,
+			strings.Join(difference.Base, ""), strings.Join(difference.Other, ""))
+	default:
+		lines := []string{fmt.Sprintf("~ %s: %s", difference.TestCase, difference.Field)}
+		for _, object := range difference.Base {
+			lines = append(lines, "    - "+object)
+		}
+
+		for _, object := range difference.Other {
+			lines = append(lines, "    + "+object)
+		}
+
+		return strings.Join(lines, "\n")
+	}
+}
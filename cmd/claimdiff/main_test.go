@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-claim/pkg/claim"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/stretchr/testify/assert"
+)
+
+func generateTestResult(state, skipReason, checkDetails string) claim.Result {
+	return claim.Result{
+		CatalogInfo:            &claim.CatalogInfo{},
+		CategoryClassification: &claim.CategoryClassification{},
+		TestID:                 &claim.Identifier{},
+		State:                  state,
+		SkipReason:             skipReason,
+		CheckDetails:           checkDetails,
+	}
+}
+
+func writeTestClaim(t *testing.T, results map[string]claim.Result) string {
+	t.Helper()
+
+	reportDir := t.TempDir()
+
+	encoded, err := json.Marshal(claim.Root{Claim: &claim.Claim{
+		Configurations: map[string]interface{}{},
+		Nodes:          map[string]interface{}{},
+		Metadata:       &claim.Metadata{StartTime: "2024-01-01 00:00:00 +0000 UTC", EndTime: "2024-01-01 00:01:00 +0000 UTC"},
+		Versions:       &claim.Versions{ClaimFormat: globalparameters.ClaimFormatVersion},
+		Results:        results,
+	}})
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(filepath.Join(reportDir, globalparameters.DefaultClaimFileName), encoded, 0600))
+
+	return reportDir
+}
+
+func writeTestClaims(t *testing.T) (string, string) {
+	t.Helper()
+
+	checkDetails, err := json.Marshal(globalhelper.CheckDetails{NonCompliantObjectsOut: []*globalhelper.ReportObject{
+		{ObjectType: "Pod", ObjectFieldsKeys: []string{"Namespace", "Pod Name"}, ObjectFieldsValues: []string{"ns", "a"}},
+	}})
+	assert.Nil(t, err)
+
+	base := writeTestClaim(t, map[string]claim.Result{
+		"access-control-pod-host-pid":  generateTestResult(globalparameters.TestCasePassed, "", ""),
+		"access-control-pod-host-ipc":  generateTestResult(globalparameters.TestCasePassed, "", ""),
+		"access-control-pod-host-path": generateTestResult(globalparameters.TestCaseSkipped, "no pods", ""),
+	})
+	other := writeTestClaim(t, map[string]claim.Result{
+		"access-control-pod-host-pid":     generateTestResult(globalparameters.TestCaseFailed, "", string(checkDetails)),
+		"access-control-pod-host-path":    generateTestResult(globalparameters.TestCaseSkipped, "no containers", ""),
+		"access-control-pod-host-network": generateTestResult(globalparameters.TestCasePassed, "", ""),
+	})
+
+	return base, other
+}
+
+func TestRunText(t *testing.T) {
+	base, other := writeTestClaims(t)
+
+	var stdout, stderr bytes.Buffer
+
+	exitCode, err := run([]string{base, filepath.Join(other, globalparameters.DefaultClaimFileName)}, &stdout, &stderr)
+	assert.Nil(t, err)
+	assert.Equal(t, exitCodeDifferent, exitCode)
+	assert.Equal(t, `- access-control-pod-host-ipc: removed
++ access-control-pod-host-network: added
+~ access-control-pod-host-path: skip reason "no pods" -> "no containers"
+~ access-control-pod-host-pid: state "passed" -> "failed"
+~ access-control-pod-host-pid: non-compliant objects
+    + Pod{Namespace=ns, Pod Name=a}
+`, stdout.String())
+}
+
+func TestRunJSON(t *testing.T) {
+	base, other := writeTestClaims(t)
+
+	var stdout, stderr bytes.Buffer
+
+	exitCode, err := run([]string{"-o", "json", "-tc", "access-control-pod-host-pid", base, other}, &stdout, &stderr)
+	assert.Nil(t, err)
+	assert.Equal(t, exitCodeDifferent, exitCode)
+
+	var report diffReport
+
+	assert.Nil(t, json.Unmarshal(stdout.Bytes(), &report))
+	assert.Equal(t, base, report.Base)
+	assert.Len(t, report.Differences, 2)
+	assert.Equal(t, globalhelper.ClaimDiffFieldState, report.Differences[0].Field)
+
+	stdout.Reset()
+
+	exitCode, err = run([]string{"-o", "json", base, base}, &stdout, &stderr)
+	assert.Nil(t, err)
+	assert.Equal(t, exitCodeSame, exitCode)
+	assert.Contains(t, stdout.String(), `"differences": []`)
+}
+
+func TestRunOtherClaimFormat(t *testing.T) {
+	base, other := writeTestClaims(t)
+	otherClaimPath := filepath.Join(other, globalparameters.DefaultClaimFileName)
+
+	content, err := os.ReadFile(otherClaimPath)
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(otherClaimPath, bytes.Replace(content, []byte(`"claimFormat":"`+globalparameters.ClaimFormatVersion),
+		[]byte(`"claimFormat":"v0.6.0`), 1), 0600))
+
+	var stdout, stderr bytes.Buffer
+
+	exitCode, err := run([]string{base, other}, &stdout, &stderr)
+	assert.Nil(t, err)
+	assert.Equal(t, exitCodeDifferent, exitCode)
+	assert.Contains(t, stderr.String(), "warning: "+otherClaimPath+": incompatible claim format version v0.6.0")
+}
+
+func TestRunErrors(t *testing.T) {
+	base, _ := writeTestClaims(t)
+
+	var stdout, stderr bytes.Buffer
+
+	exitCode, err := run([]string{base}, &stdout, &stderr)
+	assert.Equal(t, errUsage, err)
+	assert.Equal(t, exitCodeError, exitCode)
+
+	exitCode, err = run([]string{"-o", "yaml", base, base}, &stdout, &stderr)
+	assert.Equal(t, errUsage, err)
+	assert.Equal(t, exitCodeError, exitCode)
+
+	exitCode, err = run([]string{base, filepath.Join(base, "missing")}, &stdout, &stderr)
+	assert.NotNil(t, err)
+	assert.Equal(t, exitCodeError, exitCode)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+)
+
+// catalogSuites are the certsuite suites prefixing the test case names.
+var catalogSuites = []string{
+	globalparameters.AccessControlSuiteName,
+	globalparameters.AffiliatedCertificationSuiteName,
+	globalparameters.LifecycleSuiteName,
+	globalparameters.ManageabilitySuiteName,
+	globalparameters.NetworkSuiteName,
+	globalparameters.ObservabilitySuiteName,
+	globalparameters.OperatorSuiteName,
+	globalparameters.PerformanceSuiteName,
+	globalparameters.PlatformAlterationSuiteName,
+	globalparameters.PreflightSuiteName,
+}
+
+var catalogTestCaseRegex = regexp.MustCompile(`\b(?:` + strings.Join(catalogSuites, "|") + `)(?:-[a-z0-9]+)+\b`)
+
+// readCatalog returns the certsuite test cases of a catalog: a claim file run with all the checks,
+// the report directory holding it, or a text listing them such as the certsuite info output or
+// the certsuite CATALOG.md. "-" reads the catalog from stdin.
+func readCatalog(catalogPath string, stdin io.Reader) ([]string, error) {
+	var (
+		content []byte
+		err     error
+	)
+
+	if catalogPath == "-" {
+		content, err = io.ReadAll(stdin)
+	} else {
+		content, err = readCatalogFile(catalogPath)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog %s: %w", catalogPath, err)
+	}
+
+	var testCases []string
+
+	// The results of claims of any format version, without validating the rest of the claim.
+	var claimResults struct {
+		Claim struct {
+			Results map[string]json.RawMessage `json:"results"`
+		} `json:"claim"`
+	}
+
+	if json.Unmarshal(content, &claimResults) == nil && len(claimResults.Claim.Results) > 0 {
+		for testCase := range claimResults.Claim.Results {
+			testCases = append(testCases, testCase)
+		}
+	} else {
+		testCases = catalogTestCaseRegex.FindAllString(string(content), -1)
+	}
+
+	if len(testCases) == 0 {
+		return nil, fmt.Errorf("no certsuite test case found in catalog %s", catalogPath)
+	}
+
+	sort.Strings(testCases)
+
+	return compact(testCases), nil
+}
+
+func readCatalogFile(catalogPath string) ([]byte, error) {
+	info, err := os.Stat(catalogPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		catalogPath = filepath.Join(catalogPath, globalparameters.DefaultClaimFileName)
+	}
+
+	return os.ReadFile(catalogPath)
+}
+
+// compact removes the consecutive duplicates of a sorted slice.
+func compact(values []string) []string {
+	result := values[:0]
+
+	for index, value := range values {
+		if index == 0 || value != values[index-1] {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"sort"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/cmd/internal/specsource"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+)
+
+// Coverage statuses of a certsuite test case.
+const (
+	statusCovered      = "covered"
+	statusPositiveOnly = "positive only"
+	statusNegativeOnly = "negative only"
+	statusSkippedOnly  = "skipped only"
+	statusNoSpec       = "no spec"
+	statusNotInCatalog = "not in catalog"
+)
+
+// validateFunctions are the globalhelper functions a spec calls with the test case it checks and
+// its expected states.
+var validateFunctions = map[string]bool{
+	"ValidateIfReportsAreValid":                     true,
+	"ValidateIfReportsAreValidWithAcceptedStatuses": true,
+	"ValidateIfReportsAreValidWithReason":           true,
+}
+
+// testCaseCoverage is the QE coverage of a certsuite test case. Specs are listed as
+// "<file>:<line>: <spec text>", by the state they expect.
+type testCaseCoverage struct {
+	TestCase      string   `json:"testCase"`
+	Status        string   `json:"status"`
+	PositiveSpecs []string `json:"positiveSpecs,omitempty"`
+	NegativeSpecs []string `json:"negativeSpecs,omitempty"`
+	SkippedSpecs  []string `json:"skippedSpecs,omitempty"`
+}
+
+// buildCoverage cross-references the catalog test cases with the test cases the specs under
+// testsDir validate. Test cases validated by specs but missing from the catalog are listed last.
+func buildCoverage(catalog []string, testsDir string) ([]testCaseCoverage, error) {
+	source, err := specsource.Parse(testsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	packageStrings := source.PackageStrings()
+	coverageByTestCase := map[string]*testCaseCoverage{}
+
+	for _, testCase := range catalog {
+		coverageByTestCase[testCase] = &testCaseCoverage{TestCase: testCase}
+	}
+
+	var notInCatalog []string
+
+	for _, spec := range source.Specs() {
+		if spec.Pending {
+			continue
+		}
+
+		for testCase, expectedStates := range specExpectedStates(spec, packageStrings) {
+			coverage, found := coverageByTestCase[testCase]
+			if !found {
+				coverage = &testCaseCoverage{TestCase: testCase, Status: statusNotInCatalog}
+				coverageByTestCase[testCase] = coverage
+				notInCatalog = append(notInCatalog, testCase)
+			}
+
+			specName := fmt.Sprintf("%s: %s", spec.Position, spec.Text)
+
+			for _, state := range expectedStates {
+				switch state {
+				case globalparameters.TestCasePassed:
+					coverage.PositiveSpecs = appendOnce(coverage.PositiveSpecs, specName)
+				case globalparameters.TestCaseFailed:
+					coverage.NegativeSpecs = appendOnce(coverage.NegativeSpecs, specName)
+				case globalparameters.TestCaseSkipped:
+					coverage.SkippedSpecs = appendOnce(coverage.SkippedSpecs, specName)
+				}
+			}
+		}
+	}
+
+	sort.Strings(notInCatalog)
+
+	coverages := make([]testCaseCoverage, 0, len(coverageByTestCase))
+	for _, testCase := range append(catalog, notInCatalog...) {
+		coverage := coverageByTestCase[testCase]
+		if coverage.Status == "" {
+			coverage.Status = coverageStatus(coverage)
+		}
+
+		coverages = append(coverages, *coverage)
+	}
+
+	return coverages, nil
+}
+
+func coverageStatus(coverage *testCaseCoverage) string {
+	switch {
+	case len(coverage.PositiveSpecs) > 0 && len(coverage.NegativeSpecs) > 0:
+		return statusCovered
+	case len(coverage.PositiveSpecs) > 0:
+		return statusPositiveOnly
+	case len(coverage.NegativeSpecs) > 0:
+		return statusNegativeOnly
+	case len(coverage.SkippedSpecs) > 0:
+		return statusSkippedOnly
+	default:
+		return statusNoSpec
+	}
+}
+
+// specExpectedStates returns the states a spec expects for the test cases it validates.
+func specExpectedStates(spec specsource.Spec, packageStrings map[string]string) map[string][]string {
+	expectedStates := map[string][]string{}
+
+	ast.Inspect(spec.Call, func(node ast.Node) bool {
+		call, isCall := node.(*ast.CallExpr)
+		if !isCall || len(call.Args) < 2 {
+			return true
+		}
+
+		selector, isSelector := call.Fun.(*ast.SelectorExpr)
+		if !isSelector || !validateFunctions[selector.Sel.Name] {
+			return true
+		}
+
+		testCase, found := resolveString(spec.File, call.Args[0], packageStrings)
+		if !found {
+			return true
+		}
+
+		states := []ast.Expr{call.Args[1]}
+		if list, isList := call.Args[1].(*ast.CompositeLit); isList {
+			states = list.Elts
+		}
+
+		for _, stateExpr := range states {
+			if state, found := resolveString(spec.File, stateExpr, packageStrings); found {
+				expectedStates[testCase] = append(expectedStates[testCase], state)
+			}
+		}
+
+		return true
+	})
+
+	return expectedStates
+}
+
+// resolveString returns the value of a string literal or of a package level string.
+func resolveString(file *ast.File, expr ast.Expr, packageStrings map[string]string) (string, bool) {
+	if value, isString := specsource.StringValue(expr); isString {
+		return value, true
+	}
+
+	value, found := packageStrings[specsource.PackageStringKey(file, expr)]
+
+	return value, found
+}
+
+func appendOnce(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+
+	return append(values, value)
+}
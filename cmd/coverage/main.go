@@ -0,0 +1,131 @@
+// Command coverage reports the certsuite test cases of a catalog that the QE specs do not cover,
+// or only cover with positive or only with negative specs.
+//
+// Usage:
+//
+//	coverage [-o text|json] [-tests dir] <catalog>
+//
+// The catalog is a claim file of a run with all the checks, the report directory holding it, or
+// a text listing the test cases such as the certsuite info output or the certsuite CATALOG.md,
+// "-" to read it from stdin. The specs under -tests, tests by default, cover the test cases they
+// validate with globalhelper.ValidateIfReportsAreValid: positive specs expect them to pass and
+// negative specs expect them to fail.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+
+	defaultTestsDir = "tests"
+)
+
+var errUsage = errors.New("usage: coverage [-o text|json] [-tests dir] <catalog>")
+
+// coverageReport is the JSON output of the command.
+type coverageReport struct {
+	Summary   map[string]int     `json:"summary"`
+	TestCases []testCaseCoverage `json:"testCases"`
+}
+
+// textSections are the statuses listed by the text output, with their titles.
+var textSections = []struct {
+	status string
+	title  string
+}{
+	{statusNoSpec, "Certsuite test cases without QE spec"},
+	{statusPositiveOnly, "Certsuite test cases with positive specs only"},
+	{statusNegativeOnly, "Certsuite test cases with negative specs only"},
+	{statusSkippedOnly, "Certsuite test cases with skipped specs only"},
+	{statusNotInCatalog, "QE test cases not in the catalog"},
+}
+
+func main() {
+	err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("coverage", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	output := flags.String("o", outputText, "output format, text or json")
+	testsDir := flags.String("tests", defaultTestsDir, "directory of the QE specs")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 || (*output != outputText && *output != outputJSON) {
+		return errUsage
+	}
+
+	catalog, err := readCatalog(flags.Arg(0), stdin)
+	if err != nil {
+		return err
+	}
+
+	coverages, err := buildCoverage(catalog, *testsDir)
+	if err != nil {
+		return err
+	}
+
+	summary := map[string]int{}
+	for _, coverage := range coverages {
+		summary[coverage.Status]++
+	}
+
+	if *output == outputJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(coverageReport{Summary: summary, TestCases: coverages})
+	}
+
+	return writeText(stdout, len(catalog), summary, coverages)
+}
+
+func writeText(writer io.Writer, catalogSize int, summary map[string]int, coverages []testCaseCoverage) error {
+	_, err := fmt.Fprintf(writer, "%d certsuite test cases: %d covered, %d positive only, %d negative only, "+
+		"%d skipped only, %d without spec\n", catalogSize, summary[statusCovered], summary[statusPositiveOnly],
+		summary[statusNegativeOnly], summary[statusSkippedOnly], summary[statusNoSpec])
+	if err != nil {
+		return err
+	}
+
+	for _, section := range textSections {
+		if summary[section.status] == 0 {
+			continue
+		}
+
+		_, err = fmt.Fprintf(writer, "\n%s:\n", section.title)
+		if err != nil {
+			return err
+		}
+
+		for _, coverage := range coverages {
+			if coverage.Status != section.status {
+				continue
+			}
+
+			_, err = fmt.Fprintf(writer, "  %s\n", coverage.TestCase)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSpecsSource = `package tests
+
+import (
+	"example.com/qe/tests/globalparameters"
+	tsparams "example.com/qe/tests/parameters"
+)
+
+var _ = Describe("Access-control", func() {
+	It("HostPid false", func() {
+		err := globalhelper.ValidateIfReportsAreValid(tsparams.TestCaseNamePodHostPid,
+			globalparameters.TestCasePassed, randomReportDir)
+	})
+
+	It("HostPid true [negative]", func() {
+		err := globalhelper.ValidateIfReportsAreValid(tsparams.TestCaseNamePodHostPid,
+			globalparameters.TestCaseFailed, randomReportDir)
+	})
+
+	It("HostIpc false", func() {
+		err := globalhelper.ValidateIfReportsAreValidWithAcceptedStatuses("access-control-pod-host-ipc",
+			[]string{globalparameters.TestCasePassed, globalparameters.TestCaseSkipped}, randomReportDir)
+	})
+
+	It("no pods [skip]", func() {
+		err := globalhelper.ValidateIfReportsAreValidWithReason("access-control-pod-host-path",
+			globalparameters.TestCaseSkipped, globalhelper.SkipReasonNoPods, randomReportDir)
+	})
+
+	It("removed check", func() {
+		err := globalhelper.ValidateIfReportsAreValid("access-control-removed",
+			globalparameters.TestCaseFailed, randomReportDir)
+	})
+
+	XIt("pending", func() {
+		err := globalhelper.ValidateIfReportsAreValid("access-control-pod-host-network",
+			globalparameters.TestCaseFailed, randomReportDir)
+	})
+})
+`
+
+func writeTestSpecs(t *testing.T) string {
+	t.Helper()
+
+	moduleDir := t.TempDir()
+
+	for filePath, content := range map[string]string{
+		"go.mod": "module example.com/qe\n",
+		"tests/globalparameters/globalparameters.go": "package globalparameters\n\nvar (\n\tTestCasePassed = \"passed\"\n" +
+			"\tTestCaseFailed = \"failed\"\n\tTestCaseSkipped = \"skipped\"\n)\n",
+		"tests/parameters/parameters.go": "package parameters\n\nconst TestCaseNamePodHostPid = \"access-control-pod-host-pid\"\n",
+		"tests/tests/specs.go":           testSpecsSource,
+	} {
+		filePath = filepath.Join(moduleDir, filePath)
+		assert.Nil(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		assert.Nil(t, os.WriteFile(filePath, []byte(content), 0600))
+	}
+
+	return filepath.Join(moduleDir, "tests")
+}
+
+func TestRun(t *testing.T) {
+	testsDir := writeTestSpecs(t)
+	catalog := "access-control-pod-host-pid\naccess-control-pod-host-ipc\naccess-control-pod-host-path\n" +
+		"access-control-pod-host-network\n"
+
+	var stdout, stderr bytes.Buffer
+
+	err := run([]string{"-tests", testsDir, "-"}, strings.NewReader(catalog), &stdout, &stderr)
+	assert.Nil(t, err)
+	assert.Equal(t, `4 certsuite test cases: 1 covered, 1 positive only, 0 negative only, 1 skipped only, 1 without spec
+
+Certsuite test cases without QE spec:
+  access-control-pod-host-network
+
+Certsuite test cases with positive specs only:
+  access-control-pod-host-ipc
+
+Certsuite test cases with skipped specs only:
+  access-control-pod-host-path
+
+QE test cases not in the catalog:
+  access-control-removed
+`, stdout.String())
+
+	stdout.Reset()
+
+	err = run([]string{"-o", "json", "-tests", testsDir, "-"}, strings.NewReader(catalog), &stdout, &stderr)
+	assert.Nil(t, err)
+
+	var report coverageReport
+
+	assert.Nil(t, json.Unmarshal(stdout.Bytes(), &report))
+	assert.Equal(t, 1, report.Summary[statusNotInCatalog])
+	assert.Equal(t, "access-control-pod-host-ipc", report.TestCases[0].TestCase)
+	assert.Equal(t, statusPositiveOnly, report.TestCases[0].Status)
+	assert.Len(t, report.TestCases[0].SkippedSpecs, 1)
+	assert.Equal(t, statusCovered, report.TestCases[3].Status)
+	assert.Len(t, report.TestCases[3].NegativeSpecs, 1)
+	assert.Contains(t, report.TestCases[3].NegativeSpecs[0], "specs.go:14:2: HostPid true [negative]")
+
+	assert.Equal(t, errUsage, run(nil, strings.NewReader(""), &stdout, &stderr))
+}
+
+func TestReadCatalog(t *testing.T) {
+	catalogDir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(catalogDir, "claim.json"),
+		[]byte(`{"claim": {"results": {"lifecycle-pod-owner-type": {}, "access-control-pod-host-pid": {}}}}`), 0600))
+
+	testCases, err := readCatalog(catalogDir, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"access-control-pod-host-pid", "lifecycle-pod-owner-type"}, testCases)
+
+	catalogPath := filepath.Join(catalogDir, "CATALOG.md")
+	assert.Nil(t, os.WriteFile(catalogPath, []byte("### access-control\n\n#### access-control-pod-host-pid\n\n"+
+		"Test ID|access-control-pod-host-pid\n#### networking-icmpv4-connectivity\n"), 0600))
+
+	testCases, err = readCatalog(catalogPath, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"access-control-pod-host-pid", "networking-icmpv4-connectivity"}, testCases)
+
+	_, err = readCatalog("-", strings.NewReader("no test case"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,128 @@
+// Command doctor checks the environment before a certsuite-qe run: the configuration, the
+// container engine, the images, the nodes and their labels, the catalog sources, the machine
+// config pools and the namespaces left by previous runs.
+//
+// Usage:
+//
+//	doctor [-json <file>] [-skip-images]
+//
+// The checks are printed as a table, and their verdict as JSON to the -json file, "-" for the
+// standard output, the table then being printed to the standard error. The command exits with 0
+// when no check fails, warnings included, 1 when one fails and 2 on error.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
+)
+
+const (
+	exitCodeHealthy   = 0
+	exitCodeUnhealthy = 1
+	exitCodeError     = 2
+)
+
+var errUsage = errors.New("usage: doctor [-json <file>] [-skip-images]")
+
+func main() {
+	exitCode, err := run(os.Args[1:], os.Stdout, os.Stderr, globalhelper.RunDoctor)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+
+	os.Exit(exitCode)
+}
+
+func run(args []string, stdout, stderr io.Writer, runDoctor func(images []string) *globalhelper.DoctorReport) (int, error) {
+	flags := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	jsonPath := flags.String("json", "", "file the JSON verdict is written to, - for the standard output")
+	skipImages := flags.Bool("skip-images", false, "do not check that the images can be pulled")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return exitCodeError, err
+	}
+
+	if flags.NArg() != 0 {
+		return exitCodeError, errUsage
+	}
+
+	var images []string
+	if !*skipImages {
+		images = globalhelper.DoctorImages()
+	}
+
+	report := runDoctor(images)
+
+	// The standard output only holds the verdict when it is the JSON target, so it can be parsed.
+	tableWriter := stdout
+	if *jsonPath == "-" {
+		tableWriter = stderr
+	}
+
+	err = writeTable(tableWriter, report)
+	if err != nil {
+		return exitCodeError, err
+	}
+
+	if *jsonPath != "" {
+		err = writeVerdict(*jsonPath, stdout, report)
+		if err != nil {
+			return exitCodeError, err
+		}
+	}
+
+	if report.Verdict == globalhelper.DoctorFail {
+		return exitCodeUnhealthy, nil
+	}
+
+	return exitCodeHealthy, nil
+}
+
+func writeTable(writer io.Writer, report *globalhelper.DoctorReport) error {
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(table, "STATUS\tCHECK\tMESSAGE")
+
+	for _, check := range report.Checks {
+		fmt.Fprintf(table, "%s\t%s\t%s\n", check.Status, check.Name, check.Message)
+	}
+
+	fmt.Fprintf(table, "\nverdict: %s\n", report.Verdict)
+
+	return table.Flush()
+}
+
+func writeVerdict(jsonPath string, stdout io.Writer, report *globalhelper.DoctorReport) error {
+	writer := stdout
+
+	if jsonPath != "-" {
+		jsonFile, err := os.Create(jsonPath)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", jsonPath, err)
+		}
+
+		defer jsonFile.Close()
+
+		writer = jsonFile
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(report)
+	if err != nil {
+		return fmt.Errorf("failed to write the verdict to %s: %w", jsonPath, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func fakeDoctor(verdict globalhelper.DoctorStatus) func([]string) *globalhelper.DoctorReport {
+	return func(images []string) *globalhelper.DoctorReport {
+		return &globalhelper.DoctorReport{Verdict: verdict, Checks: []globalhelper.DoctorCheck{
+			{Name: "nodes", Status: verdict, Message: "3 nodes"},
+		}}
+	}
+}
+
+func TestRun(t *testing.T) {
+	jsonPath := filepath.Join(t.TempDir(), "doctor.json")
+
+	var stdout, stderr bytes.Buffer
+
+	exitCode, err := run([]string{"-skip-images", "-json", jsonPath}, &stdout, &stderr, fakeDoctor(globalhelper.DoctorWarn))
+	assert.Nil(t, err)
+	assert.Equal(t, exitCodeHealthy, exitCode)
+	assert.Contains(t, stdout.String(), "STATUS  CHECK  MESSAGE")
+	assert.Contains(t, stdout.String(), "warn    nodes  3 nodes")
+	assert.Contains(t, stdout.String(), "verdict: warn")
+
+	content, err := os.ReadFile(jsonPath)
+	assert.Nil(t, err)
+
+	var report globalhelper.DoctorReport
+
+	assert.Nil(t, json.Unmarshal(content, &report))
+	assert.Equal(t, globalhelper.DoctorWarn, report.Verdict)
+	assert.Len(t, report.Checks, 1)
+}
+
+func TestRunFailingCheck(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	exitCode, err := run([]string{"-skip-images", "-json", "-"}, &stdout, &stderr, fakeDoctor(globalhelper.DoctorFail))
+	assert.Nil(t, err)
+	assert.Equal(t, exitCodeUnhealthy, exitCode)
+	assert.Contains(t, stderr.String(), "verdict: fail")
+
+	var report globalhelper.DoctorReport
+	assert.Nil(t, json.Unmarshal(stdout.Bytes(), &report))
+	assert.Equal(t, globalhelper.DoctorFail, report.Verdict)
+
+	exitCode, err = run([]string{"unexpected"}, &stdout, &stderr, fakeDoctor(globalhelper.DoctorPass))
+	assert.Equal(t, errUsage, err)
+	assert.Equal(t, exitCodeError, exitCode)
+}
@@ -0,0 +1,305 @@
+// Package specsource finds the ginkgo specs and the package level strings of the suites source files,
+// for the commands checking the specs without running them.
+package specsource
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// specNodes are the ginkgo spec functions, with whether the spec is pending.
+var specNodes = map[string]bool{
+	"It":  false,
+	"FIt": false,
+	"PIt": true,
+	"XIt": true,
+}
+
+// Spec is a ginkgo spec found in the sources.
+type Spec struct {
+	Position   token.Position
+	Text       string
+	PolarionID string
+	Pending    bool
+	// Call is the It call of the spec, and File the file declaring it.
+	Call *ast.CallExpr
+	File *ast.File
+}
+
+// Source is the parsed non-test go files of a directory.
+type Source struct {
+	fileSet    *token.FileSet
+	files      map[string]*ast.File
+	modulePath string
+	moduleDir  string
+}
+
+// Parse parses the non-test go files under dir, skipping vendor directories.
+func Parse(dir string) (*Source, error) {
+	moduleDir, modulePath, err := findModule(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	source := &Source{
+		fileSet:    token.NewFileSet(),
+		files:      map[string]*ast.File{},
+		modulePath: modulePath,
+		moduleDir:  moduleDir,
+	}
+
+	err = filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if entry.Name() == "vendor" {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !strings.HasSuffix(filePath, ".go") || strings.HasSuffix(filePath, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(source.fileSet, filePath, nil, 0)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", filePath, err)
+		}
+
+		source.files[filePath] = file
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", dir, err)
+	}
+
+	return source, nil
+}
+
+// Specs returns the specs of the source, in file and position order.
+func (s *Source) Specs() []Spec {
+	var specs []Spec
+
+	for _, filePath := range s.paths() {
+		file := s.files[filePath]
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, isCall := node.(*ast.CallExpr)
+			if !isCall {
+				return true
+			}
+
+			if spec, isSpec := s.parseSpec(file, call); isSpec {
+				specs = append(specs, spec)
+			}
+
+			return true
+		})
+	}
+
+	return specs
+}
+
+// PackageStrings returns the package level constants and variables of the source initialized with
+// a string literal, keyed by "<import path>.<name>".
+func (s *Source) PackageStrings() map[string]string {
+	values := map[string]string{}
+
+	for _, filePath := range s.paths() {
+		importPath := s.importPath(filepath.Dir(filePath))
+
+		for _, decl := range s.files[filePath].Decls {
+			genDecl, isGenDecl := decl.(*ast.GenDecl)
+			if !isGenDecl || (genDecl.Tok != token.CONST && genDecl.Tok != token.VAR) {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				valueSpec, isValueSpec := spec.(*ast.ValueSpec)
+				if !isValueSpec || len(valueSpec.Values) != len(valueSpec.Names) {
+					continue
+				}
+
+				for index, name := range valueSpec.Names {
+					if value, isString := StringValue(valueSpec.Values[index]); isString {
+						values[importPath+"."+name.Name] = value
+					}
+				}
+			}
+		}
+	}
+
+	return values
+}
+
+// PackageStringKey returns the PackageStrings key of a value referenced as <package>.<name> in a
+// file, empty if the expression is not such a reference.
+func PackageStringKey(file *ast.File, expr ast.Expr) string {
+	selector, isSelector := expr.(*ast.SelectorExpr)
+	if !isSelector {
+		return ""
+	}
+
+	pkg, isIdent := selector.X.(*ast.Ident)
+	if !isIdent {
+		return ""
+	}
+
+	for _, fileImport := range file.Imports {
+		importPath, err := strconv.Unquote(fileImport.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		name := filepath.Base(importPath)
+		if fileImport.Name != nil {
+			name = fileImport.Name.Name
+		}
+
+		if name == pkg.Name {
+			return importPath + "." + selector.Sel.Name
+		}
+	}
+
+	return ""
+}
+
+// StringValue returns the value of a string literal, or of a concatenation of string literals.
+func StringValue(expr ast.Expr) (string, bool) {
+	switch value := expr.(type) {
+	case *ast.BasicLit:
+		if value.Kind != token.STRING {
+			return "", false
+		}
+
+		unquoted, err := strconv.Unquote(value.Value)
+		if err != nil {
+			return "", false
+		}
+
+		return unquoted, true
+	case *ast.BinaryExpr:
+		left, isLeftString := StringValue(value.X)
+		right, isRightString := StringValue(value.Y)
+
+		return left + right, isLeftString && isRightString && value.Op == token.ADD
+	default:
+		return "", false
+	}
+}
+
+func (s *Source) parseSpec(file *ast.File, call *ast.CallExpr) (Spec, bool) {
+	name, isIdent := call.Fun.(*ast.Ident)
+	if !isIdent {
+		return Spec{}, false
+	}
+
+	pending, isSpecNode := specNodes[name.Name]
+	if !isSpecNode || len(call.Args) == 0 {
+		return Spec{}, false
+	}
+
+	text, _ := StringValue(call.Args[0])
+	spec := Spec{Position: s.fileSet.Position(call.Pos()), Text: text, Pending: pending, Call: call, File: file}
+
+	for _, arg := range call.Args[1:] {
+		decorator, isCall := arg.(*ast.CallExpr)
+		if !isCall || len(decorator.Args) != 1 {
+			continue
+		}
+
+		if selector, isSelector := decorator.Fun.(*ast.SelectorExpr); isSelector && selector.Sel.Name == "PolarionID" {
+			spec.PolarionID, _ = StringValue(decorator.Args[0])
+		}
+	}
+
+	return spec, true
+}
+
+func (s *Source) paths() []string {
+	paths := make([]string, 0, len(s.files))
+	for filePath := range s.files {
+		paths = append(paths, filePath)
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+func (s *Source) importPath(dir string) string {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return dir
+	}
+
+	relDir, err := filepath.Rel(s.moduleDir, absDir)
+	if err != nil || relDir == "." {
+		return s.modulePath
+	}
+
+	return path.Join(s.modulePath, filepath.ToSlash(relDir))
+}
+
+// findModule returns the directory and the path of the go module holding dir. Without a go.mod,
+// import paths are the directories relative to dir.
+func findModule(dir string) (string, string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to find the module of %s: %w", dir, err)
+	}
+
+	moduleDir := absDir
+
+	for {
+		modulePath, err := readModulePath(filepath.Join(moduleDir, "go.mod"))
+		if err == nil {
+			return moduleDir, modulePath, nil
+		}
+
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", "", err
+		}
+
+		parent := filepath.Dir(moduleDir)
+		if parent == moduleDir {
+			return absDir, "", nil
+		}
+
+		moduleDir = parent
+	}
+}
+
+func readModulePath(goModPath string) (string, error) {
+	goMod, err := os.Open(goModPath)
+	if err != nil {
+		return "", err
+	}
+
+	defer goMod.Close()
+
+	scanner := bufio.NewScanner(goMod)
+	for scanner.Scan() {
+		if modulePath, found := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); found {
+			return strings.Trim(strings.TrimSpace(modulePath), `"`), nil
+		}
+	}
+
+	return "", fmt.Errorf("no module path found in %s", goModPath)
+}
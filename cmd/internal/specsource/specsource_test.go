@@ -0,0 +1,98 @@
+package specsource
+
+import (
+	"go/ast"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestModule(t *testing.T) string {
+	t.Helper()
+
+	moduleDir := t.TempDir()
+
+	for filePath, content := range map[string]string{
+		"go.mod": "module example.com/qe\n",
+		"tests/parameters/parameters.go": `package parameters
+
+const (
+	TestCaseNamePodHostPid = "access-control-pod-host-pid"
+	Timeout = 5
+)
+
+var TestCasePassed = "pass" + "ed"
+`,
+		"tests/tests/specs.go": `package tests
+
+import (
+	tsparams "example.com/qe/tests/parameters"
+)
+
+var _ = Describe("Access-control pod-host-pid", func() {
+	It("one deployment, one pod, "+
+		"HostPid false", globalhelper.PolarionID("53140"), func() {
+		_ = tsparams.TestCaseNamePodHostPid
+	})
+
+	XIt("pending", func() {})
+})
+`,
+		"tests/tests/specs_test.go": `package tests
+
+var _ = It("ignored", func() {})
+`,
+	} {
+		filePath = filepath.Join(moduleDir, filePath)
+		assert.Nil(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		assert.Nil(t, os.WriteFile(filePath, []byte(content), 0600))
+	}
+
+	return moduleDir
+}
+
+func TestSource(t *testing.T) {
+	moduleDir := writeTestModule(t)
+
+	source, err := Parse(filepath.Join(moduleDir, "tests"))
+	assert.Nil(t, err)
+
+	specs := source.Specs()
+	assert.Len(t, specs, 2)
+	assert.Equal(t, "one deployment, one pod, HostPid false", specs[0].Text)
+	assert.Equal(t, "53140", specs[0].PolarionID)
+	assert.Equal(t, 8, specs[0].Position.Line)
+	assert.False(t, specs[0].Pending)
+	assert.True(t, specs[1].Pending)
+
+	assert.Equal(t, map[string]string{
+		"example.com/qe/tests/parameters.TestCaseNamePodHostPid": "access-control-pod-host-pid",
+		"example.com/qe/tests/parameters.TestCasePassed":         "passed",
+	}, source.PackageStrings())
+
+	var reference *ast.SelectorExpr
+
+	ast.Inspect(specs[0].Call, func(node ast.Node) bool {
+		if selector, isSelector := node.(*ast.SelectorExpr); isSelector && selector.Sel.Name == "TestCaseNamePodHostPid" {
+			reference = selector
+		}
+
+		return true
+	})
+
+	assert.Equal(t, "example.com/qe/tests/parameters.TestCaseNamePodHostPid", PackageStringKey(specs[0].File, reference))
+	assert.Empty(t, PackageStringKey(specs[0].File, reference.Sel))
+}
+
+func TestParseWithoutModule(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "parameters"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "parameters", "parameters.go"),
+		[]byte("package parameters\n\nconst Name = \"value\"\n"), 0600))
+
+	source, err := Parse(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"parameters.Name": "value"}, source.PackageStrings())
+}
@@ -0,0 +1,148 @@
+// Command janitor removes the namespaces left by certsuite-qe runs: the namespaces labeled by
+// globalhelper.CreateNamespace older than a TTL, or the ones of a given run. With -legacy, the
+// unlabeled namespaces named like the QE ones, the certsuite probe namespace included, are deleted
+// too. The labeled namespaces stuck terminating are finalized, removing the finalizers of their
+// objects. Other namespaces are never touched. It only needs a kubeconfig, KUBECONFIG or
+// ~/.kube/config, and works on any Kubernetes cluster.
+//
+// Usage:
+//
+//	janitor [-ttl 6h] [-run-id <id>] [-legacy] [-stuck-after 10m] [-dry-run] [-o text|json]
+//
+// The command exits with 0 when all the selected namespaces were removed, 1 when some of them
+// could not be and 2 on error.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
+)
+
+const (
+	exitCodeClean  = 0
+	exitCodeFailed = 1
+	exitCodeError  = 2
+
+	outputText = "text"
+	outputJSON = "json"
+
+	defaultTTL        = 6 * time.Hour
+	defaultStuckAfter = 10 * time.Minute
+)
+
+var errUsage = errors.New("usage: janitor [-ttl 6h] [-run-id <id>] [-legacy] [-stuck-after 10m] [-dry-run] [-o text|json]")
+
+// janitorAction is an action of the JSON output.
+type janitorAction struct {
+	globalhelper.JanitorAction
+	Error string `json:"error,omitempty"`
+}
+
+func main() {
+	exitCode, err := run(os.Args[1:], os.Stdout, os.Stderr, globalhelper.CleanQENamespaces)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+
+	os.Exit(exitCode)
+}
+
+func run(args []string, stdout, stderr io.Writer,
+	clean func(globalhelper.JanitorOptions) ([]globalhelper.JanitorAction, error)) (int, error) {
+	flags := flag.NewFlagSet("janitor", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	var options globalhelper.JanitorOptions
+
+	flags.DurationVar(&options.TTL, "ttl", defaultTTL, "age the QE namespaces are removed at")
+	flags.StringVar(&options.RunID, "run-id", "", "remove the QE namespaces of this run, whatever their age")
+	flags.BoolVar(&options.Legacy, "legacy", false,
+		"also delete the unlabeled namespaces named like the QE ones, the certsuite probe namespace included")
+	flags.DurationVar(&options.StuckAfter, "stuck-after", defaultStuckAfter,
+		"time a namespace has to be terminating for to remove its finalizers")
+	flags.BoolVar(&options.DryRun, "dry-run", false, "only print what would be removed")
+	output := flags.String("o", outputText, "output format, text or json")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return exitCodeError, err
+	}
+
+	if flags.NArg() != 0 || (*output != outputText && *output != outputJSON) {
+		return exitCodeError, errUsage
+	}
+
+	actions, cleanErr := clean(options)
+	if actions == nil && cleanErr != nil {
+		return exitCodeError, cleanErr
+	}
+
+	if *output == outputJSON {
+		err = writeJSON(stdout, actions)
+	} else {
+		err = writeText(stdout, actions, options.DryRun)
+	}
+
+	if err != nil {
+		return exitCodeError, err
+	}
+
+	if cleanErr != nil {
+		return exitCodeFailed, cleanErr
+	}
+
+	return exitCodeClean, nil
+}
+
+func writeJSON(writer io.Writer, actions []globalhelper.JanitorAction) error {
+	output := make([]janitorAction, 0, len(actions))
+
+	for _, action := range actions {
+		outputAction := janitorAction{JanitorAction: action}
+		if action.Err != nil {
+			outputAction.Error = action.Err.Error()
+		}
+
+		output = append(output, outputAction)
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(output)
+}
+
+func writeText(writer io.Writer, actions []globalhelper.JanitorAction, dryRun bool) error {
+	if len(actions) == 0 {
+		_, err := fmt.Fprintln(writer, "no QE namespaces")
+
+		return err
+	}
+
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(table, "NAMESPACE\tACTION\tREASON")
+
+	for _, action := range actions {
+		kind := string(action.Kind)
+
+		switch {
+		case action.Err != nil:
+			kind += " (failed)"
+		case dryRun && action.Kind != globalhelper.JanitorKeep:
+			kind += " (dry run)"
+		}
+
+		fmt.Fprintf(table, "%s\t%s\t%s\n", action.Namespace, kind, action.Reason)
+	}
+
+	return table.Flush()
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	var receivedOptions globalhelper.JanitorOptions
+
+	clean := func(options globalhelper.JanitorOptions) ([]globalhelper.JanitorAction, error) {
+		receivedOptions = options
+
+		return []globalhelper.JanitorAction{
+			{Namespace: "networking-ns-abcdefghij", Kind: globalhelper.JanitorDelete, Reason: "run 1234, age 7h0m0s"},
+			{Namespace: "operator-ns-abcdefghij", Kind: globalhelper.JanitorKeep, Reason: "run 5678"},
+		}, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	exitCode, err := run([]string{"-run-id", "1234", "-dry-run"}, &stdout, &stderr, clean)
+	assert.Nil(t, err)
+	assert.Equal(t, exitCodeClean, exitCode)
+	assert.Equal(t, globalhelper.JanitorOptions{TTL: defaultTTL, RunID: "1234", StuckAfter: defaultStuckAfter, DryRun: true},
+		receivedOptions)
+	assert.Contains(t, stdout.String(), "networking-ns-abcdefghij  delete (dry run)  run 1234, age 7h0m0s")
+	assert.Contains(t, stdout.String(), "operator-ns-abcdefghij    keep              run 5678")
+
+	stdout.Reset()
+
+	exitCode, err = run([]string{"-ttl", "1h", "-o", "json"}, &stdout, &stderr, clean)
+	assert.Nil(t, err)
+	assert.Equal(t, exitCodeClean, exitCode)
+	assert.Equal(t, time.Hour, receivedOptions.TTL)
+	assert.False(t, receivedOptions.Legacy)
+
+	var actions []janitorAction
+
+	assert.Nil(t, json.Unmarshal(stdout.Bytes(), &actions))
+	assert.Len(t, actions, 2)
+	assert.Equal(t, globalhelper.JanitorDelete, actions[0].Kind)
+
+	// The defaults of scripts/delete-namespaces.sh.
+	exitCode, err = run([]string{"-ttl", "0", "-legacy"}, &stdout, &stderr, clean)
+	assert.Nil(t, err)
+	assert.Equal(t, exitCodeClean, exitCode)
+	assert.Equal(t, globalhelper.JanitorOptions{StuckAfter: defaultStuckAfter, Legacy: true}, receivedOptions)
+
+	exitCode, err = run([]string{"-o", "yaml"}, &stdout, &stderr, clean)
+	assert.Equal(t, errUsage, err)
+	assert.Equal(t, exitCodeError, exitCode)
+}
+
+func TestRunFailedAction(t *testing.T) {
+	deleteErr := errors.New("forbidden")
+
+	clean := func(globalhelper.JanitorOptions) ([]globalhelper.JanitorAction, error) {
+		return []globalhelper.JanitorAction{
+			{Namespace: "networking-ns-abcdefghij", Kind: globalhelper.JanitorDelete, Err: deleteErr},
+		}, deleteErr
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	exitCode, err := run([]string{"-o", "json"}, &stdout, &stderr, clean)
+	assert.Equal(t, deleteErr, err)
+	assert.Equal(t, exitCodeFailed, exitCode)
+	assert.Contains(t, stdout.String(), `"error": "forbidden"`)
+
+	clean = func(globalhelper.JanitorOptions) ([]globalhelper.JanitorAction, error) {
+		return nil, errors.New("failed to list QE namespaces")
+	}
+
+	exitCode, err = run(nil, &stdout, &stderr, clean)
+	assert.NotNil(t, err)
+	assert.Equal(t, exitCodeError, exitCode)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/cmd/internal/specsource"
+)
+
+// checkPolarionIDs returns the problems of the Polarion IDs of the specs in the go files under
+// dir: specs without an ID, except pending ones, and IDs used by several specs.
+func checkPolarionIDs(dir string) ([]string, error) {
+	source, err := specsource.Parse(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+
+	specsByID := map[string][]specsource.Spec{}
+
+	for _, spec := range source.Specs() {
+		if spec.PolarionID == "" {
+			if !spec.Pending {
+				problems = append(problems, fmt.Sprintf("%s: spec %q has no polarion ID", spec.Position, spec.Text))
+			}
+
+			continue
+		}
+
+		specsByID[spec.PolarionID] = append(specsByID[spec.PolarionID], spec)
+	}
+
+	for polarionID, idSpecs := range specsByID {
+		if len(idSpecs) < 2 {
+			continue
+		}
+
+		positions := make([]string, 0, len(idSpecs))
+		for _, spec := range idSpecs {
+			positions = append(positions, spec.Position.String())
+		}
+
+		problems = append(problems, fmt.Sprintf("%s: polarion ID %s is used by %d specs: %s",
+			idSpecs[0].Position, polarionID, len(idSpecs), strings.Join(positions, ", ")))
+	}
+
+	sort.Strings(problems)
+
+	return problems, nil
+}
@@ -0,0 +1,125 @@
+// Command polarion checks the Polarion test case IDs of the specs, and exports the results of a
+// run to Polarion.
+//
+// Usage:
+//
+//	polarion check [dir]
+//	polarion export -project <id> [-testrun-id <id>] [-testrun-title <title>] [-id-prefix <prefix>]
+//		[-out <file>] <report dir>
+//
+// check lists the specs under dir, tests by default, without a globalhelper.PolarionID label and
+// the IDs used by several specs, and fails if there is any. export writes a Polarion XUnit import
+// file of the specs with an ID from the JUnit XML reports of a report directory, to stdout by
+// default.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
+)
+
+const defaultCheckDir = "tests"
+
+var errUsage = errors.New("usage: polarion check [dir] | polarion export -project <id> [-testrun-id <id>] " +
+	"[-testrun-title <title>] [-id-prefix <prefix>] [-out <file>] <report dir>")
+
+func main() {
+	err := run(os.Args[1:], os.Stdout, os.Stderr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "check":
+		return runCheck(args[1:], stdout)
+	case "export":
+		return runExport(args[1:], stdout, stderr)
+	default:
+		return errUsage
+	}
+}
+
+func runCheck(args []string, stdout io.Writer) error {
+	if len(args) > 1 {
+		return errUsage
+	}
+
+	dir := defaultCheckDir
+	if len(args) == 1 {
+		dir = args[0]
+	}
+
+	problems, err := checkPolarionIDs(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, problem := range problems {
+		_, err = fmt.Fprintln(stdout, problem)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%d polarion ID problems found in %s", len(problems), dir)
+	}
+
+	return nil
+}
+
+func runExport(args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("polarion export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	var options globalhelper.PolarionExportOptions
+
+	flags.StringVar(&options.ProjectID, "project", "", "polarion project ID")
+	flags.StringVar(&options.TestRunID, "testrun-id", "", "polarion test run ID")
+	flags.StringVar(&options.TestRunTitle, "testrun-title", "", "polarion test run title")
+	flags.StringVar(&options.IDPrefix, "id-prefix", "", "prefix of the polarion test case IDs, e.g. OCP-")
+	out := flags.String("out", "", "file to write the xunit file to, stdout by default")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 || options.ProjectID == "" {
+		return errUsage
+	}
+
+	writer := stdout
+
+	if *out != "" {
+		outFile, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", *out, err)
+		}
+
+		defer outFile.Close()
+
+		writer = outFile
+	}
+
+	exported, err := globalhelper.ExportPolarionXUnit(flags.Arg(0), options, writer)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(stderr, "%d polarion test cases exported\n", exported)
+
+	return err
+}
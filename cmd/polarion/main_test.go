@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSpecsSource = `package tests
+
+var _ = Describe("Access-control pod-host-pid", func() {
+	It("one deployment, one pod, HostPid false", globalhelper.PolarionID("53140"), func() {})
+
+	It("one deployment, one pod, "+
+		"HostPid true [negative]", globalhelper.PolarionID("53141"), func() {})
+
+	It("two deployments, one pod each, HostPids false", globalhelper.PolarionID("53140"), func() {})
+
+	It("no polarion ID", func() {})
+
+	XIt("pending without polarion ID", func() {})
+})
+`
+
+func TestRunCheck(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "specs.go"), []byte(testSpecsSource), 0600))
+
+	var stdout, stderr bytes.Buffer
+
+	err := run([]string{"check", dir}, &stdout, &stderr)
+	assert.EqualError(t, err, "2 polarion ID problems found in "+dir)
+	assert.Contains(t, stdout.String(), `specs.go:11:2: spec "no polarion ID" has no polarion ID`)
+	assert.Contains(t, stdout.String(), "specs.go:4:2: polarion ID 53140 is used by 2 specs")
+	assert.NotContains(t, stdout.String(), "pending")
+
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "specs.go"), []byte(`package tests
+
+var _ = Describe("Access-control pod-host-pid", func() {
+	It("one deployment, one pod, HostPid false", globalhelper.PolarionID("53140"), func() {})
+})
+`), 0600))
+	assert.Nil(t, run([]string{"check", dir}, &stdout, &stderr))
+}
+
+func TestRunUsage(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	assert.Equal(t, errUsage, run(nil, &stdout, &stderr))
+	assert.Equal(t, errUsage, run([]string{"unknown"}, &stdout, &stderr))
+	assert.Equal(t, errUsage, run([]string{"export", t.TempDir()}, &stdout, &stderr))
+}
+
+func TestRunExport(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	err := run([]string{"export", "-project", "CERTSUITE", t.TempDir()}, &stdout, &stderr)
+	assert.Nil(t, err)
+	assert.Contains(t, stdout.String(), `<property name="polarion-project-id" value="CERTSUITE"></property>`)
+	assert.Equal(t, "0 polarion test cases exported\n", stderr.String())
+}
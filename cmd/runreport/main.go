@@ -0,0 +1,101 @@
+// Command runreport aggregates the claims copied in the Debug folders of a certsuite-qe report
+// directory, and the suites JUnit XML reports, into a single HTML and Markdown report.
+//
+// Usage:
+//
+//	runreport <report dir>
+//
+// The reports are written as run-report.html and run-report.md in the report directory, so that
+// their links to the debug files are relative to it. The command exits with 0 when every test case
+// is in the expected state, 1 when some are not and 2 on error.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
+)
+
+const (
+	exitCodeExpected   = 0
+	exitCodeMismatches = 1
+	exitCodeError      = 2
+
+	htmlReportFileName     = "run-report.html"
+	markdownReportFileName = "run-report.md"
+)
+
+var errUsage = errors.New("usage: runreport <report dir>")
+
+func main() {
+	exitCode, err := run(os.Args[1:], os.Stdout, os.Stderr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+
+	os.Exit(exitCode)
+}
+
+func run(args []string, stdout, stderr io.Writer) (int, error) {
+	flags := flag.NewFlagSet("runreport", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	err := flags.Parse(args)
+	if err != nil {
+		return exitCodeError, err
+	}
+
+	if flags.NArg() != 1 {
+		return exitCodeError, errUsage
+	}
+
+	reportDir := flags.Arg(0)
+
+	report, err := globalhelper.BuildRunReport(reportDir)
+	if err != nil {
+		return exitCodeError, err
+	}
+
+	for fileName, write := range map[string]func(io.Writer) error{
+		htmlReportFileName:     report.WriteHTML,
+		markdownReportFileName: report.WriteMarkdown,
+	} {
+		err = writeReport(filepath.Join(reportDir, fileName), write)
+		if err != nil {
+			return exitCodeError, err
+		}
+	}
+
+	_, err = fmt.Fprintf(stdout, "%d certsuite test cases, %d not in the expected state, report written to %s\n",
+		len(report.Rows), report.Mismatches(), filepath.Join(reportDir, htmlReportFileName))
+	if err != nil {
+		return exitCodeError, err
+	}
+
+	if report.Mismatches() > 0 {
+		return exitCodeMismatches, nil
+	}
+
+	return exitCodeExpected, nil
+}
+
+func writeReport(reportPath string, write func(io.Writer) error) error {
+	reportFile, err := os.Create(reportPath)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", reportPath, err)
+	}
+
+	defer reportFile.Close()
+
+	err = write(reportFile)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", reportPath, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-claim/pkg/claim"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testSpec     = "Access-control pod-host-pid one deployment, one pod, HostPid true [negative]"
+	testJUnitXML = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="1" failures="1">
+  <testsuite name="CNFCert access-control tests" tests="1" failures="1">
+    <testcase name="[It] ` + testSpec + ` [accesscontrol9]" classname="CNFCert access-control tests" status="failed" time="90">
+      <failure message="" type="failed"></failure>
+    </testcase>
+  </testsuite>
+</testsuites>`
+)
+
+// writeTestReportDir writes a report directory holding the JUnit report of a spec and its debug
+// folder, whose claim state is not the one the spec expected.
+func writeTestReportDir(t *testing.T, actualState string) string {
+	t.Helper()
+
+	reportDir := t.TempDir()
+	specDir := filepath.Join(reportDir, "Debug", globalparameters.AccessControlSuiteName,
+		globalhelper.ConvertSpecNameToFileName(testSpec))
+
+	encodedClaim, err := json.Marshal(claim.Root{Claim: &claim.Claim{
+		Configurations: map[string]interface{}{},
+		Nodes:          map[string]interface{}{},
+		Metadata:       &claim.Metadata{StartTime: "2024-01-01 00:00:00 +0000 UTC", EndTime: "2024-01-01 00:01:00 +0000 UTC"},
+		Versions:       &claim.Versions{CertSuite: "v5.4.0", ClaimFormat: globalparameters.ClaimFormatVersion},
+		Results: map[string]claim.Result{"access-control-pod-host-pid": {
+			CatalogInfo:            &claim.CatalogInfo{},
+			CategoryClassification: &claim.CategoryClassification{},
+			TestID:                 &claim.Identifier{Id: "access-control-pod-host-pid", Suite: "access-control"},
+			State:                  actualState,
+		}},
+	}})
+	assert.Nil(t, err)
+
+	encodedResults, err := json.Marshal([]globalhelper.CertsuiteResult{{
+		Spec:     testSpec,
+		TestCase: "access-control-pod-host-pid",
+		Expected: []string{globalparameters.TestCaseFailed},
+		Actual:   actualState,
+	}})
+	assert.Nil(t, err)
+
+	assert.Nil(t, os.MkdirAll(specDir, globalparameters.DirPermissions))
+
+	for filePath, content := range map[string][]byte{
+		filepath.Join(specDir, globalparameters.DefaultClaimFileName): encodedClaim,
+		filepath.Join(specDir, globalhelper.CertsuiteResultFileName):  encodedResults,
+		filepath.Join(specDir, "certsuite.log"):                       []byte("log"),
+		filepath.Join(reportDir, "access_control_suite_test.xml"):     []byte(testJUnitXML),
+	} {
+		assert.Nil(t, os.WriteFile(filePath, content, 0600))
+	}
+
+	return reportDir
+}
+
+func TestRun(t *testing.T) {
+	reportDir := writeTestReportDir(t, globalparameters.TestCasePassed)
+
+	var stdout, stderr bytes.Buffer
+
+	exitCode, err := run([]string{reportDir}, &stdout, &stderr)
+	assert.Nil(t, err)
+	assert.Equal(t, exitCodeMismatches, exitCode)
+	assert.Contains(t, stdout.String(), "1 certsuite test cases, 1 not in the expected state")
+
+	markdown, err := os.ReadFile(filepath.Join(reportDir, markdownReportFileName))
+	assert.Nil(t, err)
+	assert.Contains(t, string(markdown), "| access-control | "+testSpec+" | failed | access-control-pod-host-pid | failed | "+
+		"**passed** :x: |")
+	assert.Contains(t, string(markdown), "[certsuite.log](Debug/access-control/")
+
+	html, err := os.ReadFile(filepath.Join(reportDir, htmlReportFileName))
+	assert.Nil(t, err)
+	assert.Contains(t, string(html), `<tr class="mismatch">`)
+
+	reportDir = writeTestReportDir(t, globalparameters.TestCaseFailed)
+
+	exitCode, err = run([]string{reportDir}, &stdout, &stderr)
+	assert.Nil(t, err)
+	assert.Equal(t, exitCodeExpected, exitCode)
+
+	exitCode, err = run(nil, &stdout, &stderr)
+	assert.Equal(t, errUsage, err)
+	assert.Equal(t, exitCodeError, exitCode)
+}
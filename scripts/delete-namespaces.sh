@@ -2,18 +2,13 @@
 
 # A script that will pre-emptively delete namespaces that are used in QE testing to make
 # sure that the namespaces are not left behind after the test run.
+#
+# By default every QE namespace is deleted, whatever its age: the ones labeled by certsuite-qe and
+# the unlabeled ones named like them, the certsuite probe namespace included, see cmd/janitor. Only
+# the labeled ones are finalized when stuck terminating. The arguments are passed to the janitor
+# after these defaults, e.g. "-legacy=false -run-id <run ID>" or "-ttl 2h -dry-run". The kubeconfig
+# is KUBECONFIG, or ~/.kube/config.
 
-# Check if oc is installed, if not exit gracefully
-if ! command -v oc &>/dev/null; then
-	echo >&2 "oc is not installed. Skipping deletion of namespaces"
-	exit 0
-fi
+SCRIPT_DIR=$(dirname "$(realpath "$0")")
 
-NAMESPACE_STRINGS_TO_GREP=(accesscontrol ac-test ac-rq-test my-ns affiliated lifecycle-tests manageability networking net-tests observability operator-ns performance platform-alteration certsuite)
-
-for NS in "${NAMESPACE_STRINGS_TO_GREP[@]}"; do
-	for NAMESPACE in $(oc get namespaces | grep "$NS" | awk '{print $1}'); do
-		echo "Deleting namespace $NAMESPACE"
-		oc delete namespace "$NAMESPACE" --ignore-not-found=true
-	done
-done
+cd "$SCRIPT_DIR/.." && go run ./cmd/janitor -ttl 0 -legacy "$@"
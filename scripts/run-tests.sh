@@ -25,6 +25,10 @@ if [[ ${ENABLE_FLAKY_RETRY} == "true" ]]; then
 	FFLAG="--flake-attempts=2"
 fi
 
+# The run ID labels the namespaces created by every suite of this invocation, see cmd/janitor.
+export CERTSUITE_QE_RUN_ID="${CERTSUITE_QE_RUN_ID:-${JOB_ID:-local-$(date +%Y%m%d%H%M%S)-$$}}"
+echo "Run ID: ${CERTSUITE_QE_RUN_ID}"
+
 function run_tests {
 	case $1 in
 	all)
@@ -8,15 +8,28 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	tsparams "github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/accesscontrol/parameters"
 	_ "github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/accesscontrol/tests"
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
 )
 
 func TestAccessControl(t *testing.T) {
+	globalhelper.RegisterLaunchPolicies(tsparams.LaunchPolicies)
 	globalhelper.RunSuite(t, "CNFCert access-control tests")
 }
 
-var _ = SynchronizedBeforeSuite(func() {
+var _ = SynchronizedBeforeSuite(func() []byte {
 	err := globalhelper.AllowAuthenticatedUsersRunPrivilegedContainers()
 	Expect(err).ToNot(HaveOccurred(), "Error creating namespace")
-}, func() {})
+
+	By("Check certsuite image")
+	Expect(globalhelper.ValidateCertsuiteImage()).To(Succeed(), "Error checking certsuite image")
+
+	By("Build certsuite binary")
+	certsuiteBinary, err := globalhelper.BuildCertsuiteBinary()
+	Expect(err).ToNot(HaveOccurred(), "Error building certsuite binary")
+
+	return []byte(certsuiteBinary)
+}, func(certsuiteBinary []byte) {
+	globalhelper.SetCertsuiteBinary(string(certsuiteBinary))
+})
@@ -96,4 +96,12 @@ const (
 
 	RelatimeKernelMachineConfigName = "999-rtkernel-certsuite-qe"
 	RealtimeWorkerNodeLabelValue    = "certsuite-qe-realtime-kernel"
+
+	// CrdRolesSkipReason is the skip reason of access-control-crd-roles when no role applies to the CRDs.
+	CrdRolesSkipReason = "No role contains rules that apply to at least one CRD under test"
 )
+
+// LaunchPolicies sets the certsuite run timeout and attempts of the access-control test cases.
+var LaunchPolicies = map[string]globalparameters.LaunchPolicy{
+	globalparameters.AccessControlSuiteName: {Timeout: 15 * time.Minute},
+}
@@ -40,8 +40,7 @@ var _ = Describe("Access-control container-host-port,", Label("accesscontrol1"),
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 63884
-	It("one deployment, one pod, one container not declaring host port", func() {
+	It("one deployment, one pod, one container not declaring host port", globalhelper.PolarionID("63884"), func() {
 		By("Define deployment with container without host port")
 		dep, err := tshelper.DefineDeployment(1, 1, "acdeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -68,8 +67,7 @@ var _ = Describe("Access-control container-host-port,", Label("accesscontrol1"),
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63885
-	It("one deployment, one pod, one container declaring host port [negative]", func() {
+	It("one deployment, one pod, one container declaring host port [negative]", globalhelper.PolarionID("63885"), func() {
 		ports := []corev1.ContainerPort{{ContainerPort: 22223, HostPort: 22222}}
 
 		By("Define deployment with container declaring host port")
@@ -100,8 +98,7 @@ var _ = Describe("Access-control container-host-port,", Label("accesscontrol1"),
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63886
-	It("one deployment, one pod, two containers, neither declaring host port", func() {
+	It("one deployment, one pod, two containers, neither declaring host port", globalhelper.PolarionID("63886"), func() {
 		ports := []corev1.ContainerPort{{ContainerPort: 22222}, {ContainerPort: 22223}}
 
 		By("Define deployment with containers not declaring host port")
@@ -136,8 +133,7 @@ var _ = Describe("Access-control container-host-port,", Label("accesscontrol1"),
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63887
-	It("one deployment, one pod, two containers, one declaring host port [negative]", func() {
+	It("one deployment, one pod, two containers, one declaring host port [negative]", globalhelper.PolarionID("63887"), func() {
 		ports := []corev1.ContainerPort{{ContainerPort: 22221}, {ContainerPort: 22222, HostPort: 22223}}
 
 		By("Define deployment with one container declaring host port")
@@ -39,8 +39,7 @@ var _ = Describe("Access-control non-root user,", Label("accesscontrol2", "ocp-r
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 56427
-	It("one deployment, one pod, does not have securityContext RunAsUser 0", func() {
+	It("one deployment, one pod, does not have securityContext RunAsUser 0", globalhelper.PolarionID("56427"), func() {
 		if globalhelper.IsKindCluster() {
 			// This test case deploys a pod without any securityContext fields in both pod and container level. In OCP,
 			// the most restrictive SecurityContextConstraint resource will be selected, making those fields to be automatically
@@ -75,8 +74,7 @@ var _ = Describe("Access-control non-root user,", Label("accesscontrol2", "ocp-r
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56428
-	It("one deployment, one pod, does have securityContext RunAsUser 0 [negative]", func() {
+	It("one deployment, one pod, does have securityContext RunAsUser 0 [negative]", globalhelper.PolarionID("56428"), func() {
 		By("Define deployment with securityContext RunAsUser set as 0")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -106,8 +104,7 @@ var _ = Describe("Access-control non-root user,", Label("accesscontrol2", "ocp-r
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56429
-	It("two deployments, one pod each, does not have securityContext RunAsUser 0", func() {
+	It("two deployments, one pod each, does not have securityContext RunAsUser 0", globalhelper.PolarionID("56429"), func() {
 		if globalhelper.IsKindCluster() {
 			// This test case deploys a pod without any securityContext fields in both pod and container level. In OCP,
 			// the most restrictive SecurityContextConstraint resource will be selected, making those fields to be automatically
@@ -155,8 +152,7 @@ var _ = Describe("Access-control non-root user,", Label("accesscontrol2", "ocp-r
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56430
-	It("two deployments, one pod each, one does have securityContext RunAsUser 0 [negative]", func() {
+	It("two deployments, one pod each, one does have securityContext RunAsUser 0 [negative]", globalhelper.PolarionID("56430"), func() {
 		By("Define deployments with varying securityContext RunAsUser values")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -169,7 +169,8 @@ var _ = Describe("access-control-crd-roles", Serial, Label("accesscontrol2", "oc
 		Expect(err).ToNot(HaveOccurred())
 
 		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(tsparams.CertsuiteCrdRoles, globalparameters.TestCaseSkipped, randomReportDir)
+		err = globalhelper.ValidateIfReportsAreValidWithReason(tsparams.CertsuiteCrdRoles, globalparameters.TestCaseSkipped,
+			globalhelper.ReasonEquals(tsparams.CrdRolesSkipReason), randomReportDir)
 		Expect(err).ToNot(HaveOccurred())
 	})
 })
@@ -40,8 +40,7 @@ var _ = Describe("Access-control ipc-lock-capability-check,", Label("accesscontr
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 63736
-	It("one deployment, one pod, one container, does not have ipc lock capability", func() {
+	It("one deployment, one pod, one container, does not have ipc lock capability", globalhelper.PolarionID("63736"), func() {
 		By("Define deployment without ipc lock")
 		dep, err := tshelper.DefineDeployment(1, 1, "acdeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -68,8 +67,7 @@ var _ = Describe("Access-control ipc-lock-capability-check,", Label("accesscontr
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63737
-	It("one deployment, one pod, one container, does have ipc lock capability [negative]", func() {
+	It("one deployment, one pod, one container, does have ipc lock capability [negative]", globalhelper.PolarionID("63737"), func() {
 		By("Define deployment with ipc lock")
 		dep, err := tshelper.DefineDeployment(1, 1, "acdeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -99,8 +97,7 @@ var _ = Describe("Access-control ipc-lock-capability-check,", Label("accesscontr
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63738
-	It("two deployments, one pod each, one container each, does not have ipc lock capability", func() {
+	It("two deployments, one pod each, one container each, does not have ipc lock capability", globalhelper.PolarionID("63738"), func() {
 		By("Define deployments without ipc lock")
 		dep, err := tshelper.DefineDeployment(1, 1, "acdeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -139,8 +136,8 @@ var _ = Describe("Access-control ipc-lock-capability-check,", Label("accesscontr
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63739
-	It("two deployments, one pod each, one container each, one does have ipc lock capability [negative]", func() {
+	It("two deployments, one pod each, one container each, one does have ipc lock capability "+
+		"[negative]", globalhelper.PolarionID("63739"), func() {
 		By("Define deployments with varying ipc lock capabilities")
 		dep, err := tshelper.DefineDeployment(1, 1, "acdeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
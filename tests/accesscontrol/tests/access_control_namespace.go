@@ -34,8 +34,7 @@ var _ = Describe("Access-control namespace, ", Serial, Label("accesscontrol4"),
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 51860
-	It("one namespace, no invalid prefixes", func() {
+	It("one namespace, no invalid prefixes", globalhelper.PolarionID("51860"), func() {
 		By("Define certsuite config file")
 		err := globalhelper.DefineCertsuiteConfig(
 			[]string{randomNamespace},
@@ -59,8 +58,7 @@ var _ = Describe("Access-control namespace, ", Serial, Label("accesscontrol4"),
 		Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 	})
 
-	// 51862
-	It("one namespace, namespace has invalid prefix [negative]", func() {
+	It("one namespace, namespace has invalid prefix [negative]", globalhelper.PolarionID("51862"), func() {
 		By("Create Invalid Namespace")
 		invalidNamespace := tsparams.InvalidNamespace + "-" + globalhelper.GenerateRandomString(5)
 		err := globalhelper.CreateNamespace(invalidNamespace)
@@ -94,8 +92,7 @@ var _ = Describe("Access-control namespace, ", Serial, Label("accesscontrol4"),
 		Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 	})
 
-	// 51863
-	It("two namespaces, no invalid prefixes", func() {
+	It("two namespaces, no invalid prefixes", globalhelper.PolarionID("51863"), func() {
 		By("Create additional valid namespace")
 		additionalValidNamespace := tsparams.AdditionalValidNamespace + "-" + globalhelper.GenerateRandomString(5)
 		err := globalhelper.CreateNamespace(additionalValidNamespace)
@@ -129,8 +126,7 @@ var _ = Describe("Access-control namespace, ", Serial, Label("accesscontrol4"),
 		Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 	})
 
-	// 51864
-	It("two namespaces, one has invalid prefix [negative]", func() {
+	It("two namespaces, one has invalid prefix [negative]", globalhelper.PolarionID("51864"), func() {
 		By("Create additional valid namespace")
 		additionalValidNamespace := tsparams.AdditionalValidNamespace + "-" + globalhelper.GenerateRandomString(5)
 		err := globalhelper.CreateNamespace(additionalValidNamespace)
@@ -174,8 +170,7 @@ var _ = Describe("Access-control namespace, ", Serial, Label("accesscontrol4"),
 		Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 	})
 
-	// 51971
-	It("one custom resource in a valid namespace", func() {
+	It("one custom resource in a valid namespace", globalhelper.PolarionID("51971"), func() {
 		By("Define certsuite config file")
 		err := globalhelper.DefineCertsuiteConfig(
 			[]string{randomNamespace, "certsuite"},
@@ -206,8 +201,7 @@ var _ = Describe("Access-control namespace, ", Serial, Label("accesscontrol4"),
 		Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 	})
 
-	// 52058
-	It("one custom resource in an invalid namespace [negative]", func() {
+	It("one custom resource in an invalid namespace [negative]", globalhelper.PolarionID("52058"), func() {
 		By("Create Invalid Namespace")
 		invalidNamespace := tsparams.InvalidNamespace + "-" + globalhelper.GenerateRandomString(5)
 		err := globalhelper.CreateNamespace(invalidNamespace)
@@ -248,8 +242,7 @@ var _ = Describe("Access-control namespace, ", Serial, Label("accesscontrol4"),
 		Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 	})
 
-	// 52069
-	It("two custom resources, both in valid namespaces", func() {
+	It("two custom resources, both in valid namespaces", globalhelper.PolarionID("52069"), func() {
 		By("Create additional valid namespace")
 		additionalValidNamespace := tsparams.AdditionalValidNamespace + "-" + globalhelper.GenerateRandomString(5)
 		err := globalhelper.CreateNamespace(additionalValidNamespace)
@@ -297,8 +290,7 @@ var _ = Describe("Access-control namespace, ", Serial, Label("accesscontrol4"),
 		Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 	})
 
-	// 52070
-	It("two custom resources, one in invalid namespace [negative]", func() {
+	It("two custom resources, one in invalid namespace [negative]", globalhelper.PolarionID("52070"), func() {
 		By("Create Invalid Namespace")
 		invalidNamespace := tsparams.InvalidNamespace + "-" + globalhelper.GenerateRandomString(5)
 		err := globalhelper.CreateNamespace(invalidNamespace)
@@ -346,8 +338,7 @@ var _ = Describe("Access-control namespace, ", Serial, Label("accesscontrol4"),
 		Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 	})
 
-	// 52073
-	It("two custom resources of different CRDs, both in valid namespace", func() {
+	It("two custom resources of different CRDs, both in valid namespace", globalhelper.PolarionID("52073"), func() {
 		By("Define certsuite config file")
 		err := globalhelper.DefineCertsuiteConfig(
 			[]string{randomNamespace, "certsuite"},
@@ -388,8 +379,7 @@ var _ = Describe("Access-control namespace, ", Serial, Label("accesscontrol4"),
 		Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 	})
 
-	// 52098
-	It("two custom resources of different CRDs, one in invalid namespace [negative]", func() {
+	It("two custom resources of different CRDs, one in invalid namespace [negative]", globalhelper.PolarionID("52098"), func() {
 		By("Create Additional Valid Namespace")
 		additionalValidNamespace := tsparams.AdditionalValidNamespace + "-" + globalhelper.GenerateRandomString(5)
 		err := globalhelper.CreateNamespace(additionalValidNamespace)
@@ -49,8 +49,7 @@ var _ = Describe("Access-control namespace-resource-quota,", Label("accesscontro
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56469
-	It("one deployment, one pod in a namespace with resource quota", func() {
+	It("one deployment, one pod in a namespace with resource quota", globalhelper.PolarionID("56469"), func() {
 		By("Define deployment")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -80,8 +79,7 @@ var _ = Describe("Access-control namespace-resource-quota,", Label("accesscontro
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56470
-	It("one deployment, one pod in a namespace without resource quota [negative]", func() {
+	It("one deployment, one pod in a namespace without resource quota [negative]", globalhelper.PolarionID("56470"), func() {
 		By("Define deployment")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -103,8 +101,7 @@ var _ = Describe("Access-control namespace-resource-quota,", Label("accesscontro
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56471
-	It("two deployments, one pod each, both in a namespace with resource quota", func() {
+	It("two deployments, one pod each, both in a namespace with resource quota", globalhelper.PolarionID("56471"), func() {
 		By("Define deployment 1")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -151,8 +148,7 @@ var _ = Describe("Access-control namespace-resource-quota,", Label("accesscontro
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56472
-	It("two deployments, one pod each, one in a namespace without resource quota [negative]", func() {
+	It("two deployments, one pod each, one in a namespace without resource quota [negative]", globalhelper.PolarionID("56472"), func() {
 		By("Define deployment 1")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -40,8 +40,7 @@ var _ = Describe("Access-control net-admin-capability-check,", Label("accesscont
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 63466
-	It("one deployment, one pod, one container, does not have net admin capability", func() {
+	It("one deployment, one pod, one container, does not have net admin capability", globalhelper.PolarionID("63466"), func() {
 		By("Define deployment without net admin")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -68,8 +67,7 @@ var _ = Describe("Access-control net-admin-capability-check,", Label("accesscont
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63467
-	It("one deployment, one pod, one container, does have net admin capability [negative]", func() {
+	It("one deployment, one pod, one container, does have net admin capability [negative]", globalhelper.PolarionID("63467"), func() {
 		By("Define deployment with net admin")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -99,8 +97,7 @@ var _ = Describe("Access-control net-admin-capability-check,", Label("accesscont
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63468
-	It("two deployments, one pod each, one container each, does not have net admin capability", func() {
+	It("two deployments, one pod each, one container each, does not have net admin capability", globalhelper.PolarionID("63468"), func() {
 		By("Define deployments without net admin")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -140,8 +137,8 @@ var _ = Describe("Access-control net-admin-capability-check,", Label("accesscont
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63469
-	It("two deployments, one pod each, one container each, one does have net admin capability [negative]", func() {
+	It("two deployments, one pod each, one container each, one does have net admin capability "+
+		"[negative]", globalhelper.PolarionID("63469"), func() {
 		By("Define deployments with varying net admin capabilities")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
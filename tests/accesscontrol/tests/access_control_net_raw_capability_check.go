@@ -40,8 +40,7 @@ var _ = Describe("Access-control net-raw-capability-check,", Label("accesscontro
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 63647
-	It("one deployment, one pod, one container, does not have net raw capability", func() {
+	It("one deployment, one pod, one container, does not have net raw capability", globalhelper.PolarionID("63647"), func() {
 		By("Define deployment without net raw")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -68,8 +67,7 @@ var _ = Describe("Access-control net-raw-capability-check,", Label("accesscontro
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63648
-	It("one deployment, one pod, one container, does have net raw capability [negative]", func() {
+	It("one deployment, one pod, one container, does have net raw capability [negative]", globalhelper.PolarionID("63648"), func() {
 		By("Define deployment with net raw")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -99,8 +97,7 @@ var _ = Describe("Access-control net-raw-capability-check,", Label("accesscontro
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63649
-	It("two deployments, one pod each, one container each, does not have net raw capability", func() {
+	It("two deployments, one pod each, one container each, does not have net raw capability", globalhelper.PolarionID("63649"), func() {
 		By("Define deployments without net raw")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -139,8 +136,8 @@ var _ = Describe("Access-control net-raw-capability-check,", Label("accesscontro
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63650
-	It("two deployments, one pod each, one container each, one does have net raw capability [negative]", func() {
+	It("two deployments, one pod each, one container each, one does have net raw capability "+
+		"[negative]", globalhelper.PolarionID("63650"), func() {
 		By("Define deployments with varying net raw capabilities")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
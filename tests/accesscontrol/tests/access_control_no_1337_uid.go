@@ -39,8 +39,7 @@ var _ = Describe("Access-control no-1337-uid,", Label("accesscontrol6"), func()
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 56427
-	It("one deployment, one pod, does not have securityContext RunAsUser 1337", func() {
+	It("one deployment, one pod, does not have securityContext RunAsUser 1337", globalhelper.PolarionID("56427"), func() {
 		By("Define deployment with securityContext RunAsUser not specified")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -67,8 +66,7 @@ var _ = Describe("Access-control no-1337-uid,", Label("accesscontrol6"), func()
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56428
-	It("one deployment, one pod, does have securityContext RunAsUser 1337 [negative]", func() {
+	It("one deployment, one pod, does have securityContext RunAsUser 1337 [negative]", globalhelper.PolarionID("56428"), func() {
 		By("Define deployment with securityContext RunAsUser set as 1337")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -98,8 +96,7 @@ var _ = Describe("Access-control no-1337-uid,", Label("accesscontrol6"), func()
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56429
-	It("two deployments, one pod each, does not have securityContext RunAsUser 1337", func() {
+	It("two deployments, one pod each, does not have securityContext RunAsUser 1337", globalhelper.PolarionID("56429"), func() {
 		By("Define deployments with securityContext RunAsUser not specified or not 1337")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -140,8 +137,8 @@ var _ = Describe("Access-control no-1337-uid,", Label("accesscontrol6"), func()
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56430
-	It("two deployments, one pod each, one does have securityContext RunAsUser 1337 [negative]", func() {
+	It("two deployments, one pod each, one does have securityContext RunAsUser 1337 "+
+		"[negative]", globalhelper.PolarionID("56430"), func() {
 		By("Define deployments with varying securityContext RunAsUser values")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
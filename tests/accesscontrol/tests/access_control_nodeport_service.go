@@ -44,8 +44,7 @@ var _ = Describe("Access control custom namespace, custom deployment,", Label("a
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 45447
-	It("2 custom pods, no service installed, service Should not have type of nodePort", func() {
+	It("2 custom pods, no service installed, service Should not have type of nodePort", globalhelper.PolarionID("45447"), func() {
 		By("Define deployment and create it on cluster")
 		dep, err := tshelper.DefineDeployment(3, 1, "acdeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -75,8 +74,8 @@ var _ = Describe("Access control custom namespace, custom deployment,", Label("a
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 45481
-	It("2 custom pods, service installed without NodePort, service Should not have type of nodePort", func() {
+	It("2 custom pods, service installed without NodePort, service Should not have type of "+
+		"nodePort", globalhelper.PolarionID("45481"), func() {
 		By("Define Service")
 		err := tshelper.DefineAndCreateServiceOnCluster("testservice", randomNamespace, 3022, 3022, false,
 			[]corev1.IPFamily{"IPv4"}, "SingleStack")
@@ -111,8 +110,8 @@ var _ = Describe("Access control custom namespace, custom deployment,", Label("a
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 45482
-	It("2 custom pods, multiple services installed without NodePort, service Should not have type of nodePort", func() {
+	It("2 custom pods, multiple services installed without NodePort, service Should not have type of "+
+		"nodePort", globalhelper.PolarionID("45482"), func() {
 		By("Define multiple Services")
 		err := tshelper.DefineAndCreateServiceOnCluster("testservicefirst", randomNamespace, 3022, 3022, false,
 			[]corev1.IPFamily{"IPv4"}, "SingleStack")
@@ -151,8 +150,8 @@ var _ = Describe("Access control custom namespace, custom deployment,", Label("a
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 45483
-	It("2 custom pods, service installed with NodePort, service Should not have type of nodePort [negative]", func() {
+	It("2 custom pods, service installed with NodePort, service Should not have type of nodePort "+
+		"[negative]", globalhelper.PolarionID("45483"), func() {
 		By("Define Services with NodePort")
 		err := tshelper.DefineAndCreateServiceOnCluster("testservice", randomNamespace, 30022, 3022, true,
 			[]corev1.IPFamily{"IPv4"}, "SingleStack")
@@ -189,9 +188,8 @@ var _ = Describe("Access control custom namespace, custom deployment,", Label("a
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 45484
 	It("2 custom pods, multiple services installed and one has NodePort, service Should not have type of "+
-		"nodePort [negative]", func() {
+		"nodePort [negative]", globalhelper.PolarionID("45484"), func() {
 		By("Define Services")
 		err := tshelper.DefineAndCreateServiceOnCluster("testservicefirst", randomNamespace, 30023, 3023, true,
 			[]corev1.IPFamily{"IPv4"}, "SingleStack")
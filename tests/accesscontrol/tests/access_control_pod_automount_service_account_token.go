@@ -43,8 +43,7 @@ var _ = Describe("Access-control pod-automount-service-account-token, ", Label("
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 53033
-	It("one deployment, one pod, token false", func() {
+	It("one deployment, one pod, token false", globalhelper.PolarionID("53033"), func() {
 		By("Define deployment with automountServiceAccountToken set to false")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -77,8 +76,7 @@ var _ = Describe("Access-control pod-automount-service-account-token, ", Label("
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53034
-	It("one deployment, one pod, token true [negative]", func() {
+	It("one deployment, one pod, token true [negative]", globalhelper.PolarionID("53034"), func() {
 		By("Define deployment with automountServiceAccountToken set to true")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -111,8 +109,7 @@ var _ = Describe("Access-control pod-automount-service-account-token, ", Label("
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53035
-	It("one deployment, one pod, token not set, service account's token false", func() {
+	It("one deployment, one pod, token not set, service account's token false", globalhelper.PolarionID("53035"), func() {
 		By("Define deployment with automountServiceAccountToken not set")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -147,8 +144,7 @@ var _ = Describe("Access-control pod-automount-service-account-token, ", Label("
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53036
-	It("one deployment, one pod, token not set, service account's token true [negative]", func() {
+	It("one deployment, one pod, token not set, service account's token true [negative]", globalhelper.PolarionID("53036"), func() {
 		By("Define deployment with automountServiceAccountToken not set")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -186,8 +182,7 @@ var _ = Describe("Access-control pod-automount-service-account-token, ", Label("
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53040
-	It("one deployment, one pod, token not set, service account's token not set [negative]", func() {
+	It("one deployment, one pod, token not set, service account's token not set [negative]", globalhelper.PolarionID("53040"), func() {
 		By("Define deployment with automountServiceAccountToken not set")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -224,8 +219,7 @@ var _ = Describe("Access-control pod-automount-service-account-token, ", Label("
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53054
-	It("one deployment, one pod, token false, service account's token true", func() {
+	It("one deployment, one pod, token false, service account's token true", globalhelper.PolarionID("53054"), func() {
 		By("Define deployment with automountServiceAccountToken set to false")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -265,8 +259,7 @@ var _ = Describe("Access-control pod-automount-service-account-token, ", Label("
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53036
-	It("two deployments, one pod each, tokens false", func() {
+	It("two deployments, one pod each, tokens false", globalhelper.PolarionID("53036"), func() {
 		By("Define deployments with automountServiceAccountTokens set to false")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -317,8 +310,7 @@ var _ = Describe("Access-control pod-automount-service-account-token, ", Label("
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53057
-	It("two deployments, one pod each, one token true [negative]", func() {
+	It("two deployments, one pod each, one token true [negative]", globalhelper.PolarionID("53057"), func() {
 		By("Define deployments with automountServiceAccountTokens set to different values")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -39,8 +39,7 @@ var _ = Describe("Access-control pod cluster role binding,", Label("accesscontro
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 56427
-	It("one deployment, one pod, does not have cluster role binding", func() {
+	It("one deployment, one pod, does not have cluster role binding", globalhelper.PolarionID("56427"), func() {
 		By("Define deployment that do not have cluster role binding")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
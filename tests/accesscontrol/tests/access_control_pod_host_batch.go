@@ -0,0 +1,135 @@
+package accesscontrol
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	tshelper "github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/accesscontrol/helper"
+	tsparams "github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/accesscontrol/parameters"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/deployment"
+)
+
+// The compliant cases of the pod-host-* test cases share the same deployment and certsuite
+// configuration: certsuite is run once for the test cases of each label.
+var _ = Describe("Access-control pod-host-ipc and pod-host-network batch, ", Ordered, Label("accesscontrol8"), func() {
+	batch := globalhelper.NewLaunchBatch(
+		tsparams.TestCaseNameAccessControlPodHostIpc,
+		tsparams.TestCaseNameAccessControlPodHostNetwork)
+
+	setupPodHostBatch(batch, "access-control pod-host-ipc and pod-host-network batch")
+
+	// No Polarion ID: 53140, noted for this spec before, is the ID of the HostPid spec.
+	It("one deployment, one pod, HostIpc false", func() {
+		By("Verify test case status in Claim report")
+		err := batch.ValidateIfReportsAreValid(tsparams.TestCaseNameAccessControlPodHostIpc, globalparameters.TestCasePassed)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("one deployment, one pod, HostNetwork false", globalhelper.PolarionID("53293"), func() {
+		By("Verify test case status in Claim report")
+		err := batch.ValidateIfReportsAreValid(tsparams.TestCaseNameAccessControlPodHostNetwork, globalparameters.TestCasePassed)
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
+
+var _ = Describe("Access-control pod-host-pid and pod-host-path batch, ", Ordered, Label("accesscontrol9"), func() {
+	batch := globalhelper.NewLaunchBatch(
+		tsparams.TestCaseNameAccessControlPodHostPid,
+		tsparams.TestCaseNameAccessControlPodHostPath)
+
+	setupPodHostBatch(batch, "access-control pod-host-pid and pod-host-path batch")
+
+	It("one deployment, one pod, HostPid false", globalhelper.PolarionID("53140"), func() {
+		By("Verify test case status in Claim report")
+		err := batch.ValidateIfReportsAreValid(tsparams.TestCaseNameAccessControlPodHostPid, globalparameters.TestCasePassed)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("one deployment, one pod, HostPath not set", globalhelper.PolarionID("53939"), func() {
+		By("Verify test case status in Claim report")
+		err := batch.ValidateIfReportsAreValid(tsparams.TestCaseNameAccessControlPodHostPath, globalparameters.TestCasePassed)
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
+
+// setupPodHostBatch creates a deployment using neither the host namespaces nor host paths and
+// launches the batch on it before the specs of the Ordered container.
+func setupPodHostBatch(batch *globalhelper.LaunchBatch, tcNameForReport string) {
+	var (
+		randomNamespace          string
+		randomReportDir          string
+		randomCertsuiteConfigDir string
+	)
+
+	BeforeAll(func() {
+		var podNames []string
+
+		batch.Reset()
+
+		// Create random namespace and keep original report and certsuite config directories
+		randomNamespace, randomReportDir, randomCertsuiteConfigDir =
+			globalhelper.BeforeEachSetupWithRandomPrivilegedNamespace(
+				tsparams.TestAccessControlNameSpace)
+
+		By("Define certsuite config file")
+		err := globalhelper.DefineCertsuiteConfig(
+			[]string{randomNamespace},
+			[]string{tsparams.TestPodLabel},
+			[]string{},
+			[]string{},
+			[]string{}, randomCertsuiteConfigDir)
+		Expect(err).ToNot(HaveOccurred(), "error defining certsuite config file")
+
+		By("Define deployment with hostPid, hostIPC and hostNetwork set to false and no hostPath")
+		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
+		Expect(err).ToNot(HaveOccurred())
+
+		deployment.RedefineWithHostPid(dep, false)
+		deployment.RedefineWithHostIpc(dep, false)
+		deployment.RedefineWithHostNetwork(dep, false)
+
+		By("Create deployment")
+		err = globalhelper.CreateAndWaitUntilDeploymentIsReady(dep, tsparams.Timeout)
+		if err != nil && strings.Contains(err.Error(), "not schedulable") {
+			Skip("This test cannot run because the pod is not schedulable due to insufficient resources")
+		}
+
+		Expect(err).ToNot(HaveOccurred())
+
+		By("Assert deployment does not use the host namespaces and paths")
+		runningDeployment, err := globalhelper.GetRunningDeployment(dep.Namespace, dep.Name)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(runningDeployment.Spec.Template.Spec.HostPID).To(BeFalse())
+		Expect(runningDeployment.Spec.Template.Spec.HostIPC).To(BeFalse())
+		Expect(runningDeployment.Spec.Template.Spec.HostNetwork).To(BeFalse())
+
+		for _, volume := range runningDeployment.Spec.Template.Spec.Volumes {
+			Expect(volume.HostPath).To(BeNil())
+		}
+
+		podsList, err := globalhelper.GetListOfPodsInNamespace(randomNamespace)
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, pod := range podsList.Items {
+			podNames = append(podNames, pod.Name)
+		}
+
+		By("Start tests")
+		err = batch.Launch(globalhelper.ConvertSpecNameToFileName(tcNameForReport),
+			randomReportDir, randomCertsuiteConfigDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		By("Verify certsuite discovered the deployment pod")
+		err = globalhelper.ValidateClaimDiscovery(randomReportDir, randomNamespace, globalhelper.ClaimDiscovery{Pods: podNames})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterAll(func() {
+		globalhelper.AfterEachCleanupWithRandomNamespace(randomNamespace,
+			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/deployment"
 )
 
+// The specs have no Polarion ID: 53140 to 53143, noted for them before, are the IDs of the HostPid specs.
 var _ = Describe("Access-control pod-host-ipc, ", Label("accesscontrol8"), func() {
 	var (
 		randomNamespace          string
@@ -39,37 +40,6 @@ var _ = Describe("Access-control pod-host-ipc, ", Label("accesscontrol8"), func(
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 53140
-	It("one deployment, one pod, HostIpc false", func() {
-		By("Define deployment with hostIPC set to false")
-		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
-		Expect(err).ToNot(HaveOccurred())
-
-		deployment.RedefineWithHostIpc(dep, false)
-
-		By("Create deployment")
-		err = globalhelper.CreateAndWaitUntilDeploymentIsReady(dep, tsparams.Timeout)
-		Expect(err).ToNot(HaveOccurred())
-
-		By("Assert deployment has hostIPC set to false")
-		runningDeployment, err := globalhelper.GetRunningDeployment(dep.Namespace, dep.Name)
-		Expect(err).ToNot(HaveOccurred())
-		Expect(runningDeployment.Spec.Template.Spec.HostIPC).To(BeFalse())
-
-		By("Start test")
-		err = globalhelper.LaunchTests(
-			tsparams.TestCaseNameAccessControlPodHostIpc,
-			globalhelper.ConvertSpecNameToFileName(CurrentSpecReport().FullText()), randomReportDir, randomCertsuiteConfigDir)
-		Expect(err).ToNot(HaveOccurred())
-
-		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(
-			tsparams.TestCaseNameAccessControlPodHostIpc,
-			globalparameters.TestCasePassed, randomReportDir)
-		Expect(err).ToNot(HaveOccurred())
-	})
-
-	// 53141
 	It("one deployment, one pod, HostIpc true [negative]", func() {
 		By("Define deployment with hostIPC set to true")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
@@ -93,13 +63,12 @@ var _ = Describe("Access-control pod-host-ipc, ", Label("accesscontrol8"), func(
 		Expect(err).ToNot(HaveOccurred())
 
 		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(
+		err = globalhelper.ValidateIfReportsAreValidWithReason(
 			tsparams.TestCaseNameAccessControlPodHostIpc,
-			globalparameters.TestCaseFailed, randomReportDir)
+			globalparameters.TestCaseFailed, globalhelper.ReasonMatches(`(?i)host ?ipc`), randomReportDir)
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53142
 	It("two deployments, one pod each, HostIpcs false", func() {
 		By("Define deployments with hostIPC set to false")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
@@ -144,7 +113,6 @@ var _ = Describe("Access-control pod-host-ipc, ", Label("accesscontrol8"), func(
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53143
 	It("two deployments, one pod each, one HostIpc true [negative]", func() {
 		By("Define deployments with hostIPC set to different values")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
@@ -182,9 +150,9 @@ var _ = Describe("Access-control pod-host-ipc, ", Label("accesscontrol8"), func(
 		Expect(err).ToNot(HaveOccurred())
 
 		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(
+		err = globalhelper.ValidateIfReportsAreValidWithReason(
 			tsparams.TestCaseNameAccessControlPodHostIpc,
-			globalparameters.TestCaseFailed, randomReportDir)
+			globalparameters.TestCaseFailed, globalhelper.ReasonMatches(`(?i)host ?ipc`), randomReportDir)
 		Expect(err).ToNot(HaveOccurred())
 	})
 })
@@ -39,38 +39,7 @@ var _ = Describe("Access-control pod-host-network ", Label("accesscontrol8"), fu
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 53293
-	It("one deployment, one pod, HostNetwork false", func() {
-		By("Define deployment with hostNetwork set to false")
-		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
-		Expect(err).ToNot(HaveOccurred())
-
-		deployment.RedefineWithHostNetwork(dep, false)
-
-		By("Create deployment")
-		err = globalhelper.CreateAndWaitUntilDeploymentIsReady(dep, tsparams.Timeout)
-		Expect(err).ToNot(HaveOccurred())
-
-		By("Assert deployment has hostNetwork set to false")
-		runningDeployment, err := globalhelper.GetRunningDeployment(dep.Namespace, dep.Name)
-		Expect(err).ToNot(HaveOccurred())
-		Expect(runningDeployment.Spec.Template.Spec.HostNetwork).To(BeFalse())
-
-		By("Start test")
-		err = globalhelper.LaunchTests(
-			tsparams.TestCaseNameAccessControlPodHostNetwork,
-			globalhelper.ConvertSpecNameToFileName(CurrentSpecReport().FullText()), randomReportDir, randomCertsuiteConfigDir)
-		Expect(err).ToNot(HaveOccurred())
-
-		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(
-			tsparams.TestCaseNameAccessControlPodHostNetwork,
-			globalparameters.TestCasePassed, randomReportDir)
-		Expect(err).ToNot(HaveOccurred())
-	})
-
-	// 53294
-	It("one deployment, one pod, HostNetwork true [negative]", func() {
+	It("one deployment, one pod, HostNetwork true [negative]", globalhelper.PolarionID("53294"), func() {
 		By("Define deployment with hostNetwork set to true")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -99,8 +68,7 @@ var _ = Describe("Access-control pod-host-network ", Label("accesscontrol8"), fu
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53295
-	It("two deployments, one pod each, HostNetworks false", func() {
+	It("two deployments, one pod each, HostNetworks false", globalhelper.PolarionID("53295"), func() {
 		By("Define deployments with hostNetwork set to false")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -138,8 +106,7 @@ var _ = Describe("Access-control pod-host-network ", Label("accesscontrol8"), fu
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53296
-	It("two deployments, one pod each, one HostNetwork true [negative]", func() {
+	It("two deployments, one pod each, one HostNetwork true [negative]", globalhelper.PolarionID("53296"), func() {
 		By("Define deployments with hostNetwork set to different values")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
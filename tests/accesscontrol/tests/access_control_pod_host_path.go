@@ -41,44 +41,7 @@ var _ = Describe("Access-control pod-host-path, ", Label("accesscontrol9"), func
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 53939
-	It("one deployment, one pod, HostPath not set", func() {
-		By("Define deployment with hostPath set to false")
-		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
-		Expect(err).ToNot(HaveOccurred())
-
-		By("Create deployment")
-
-		err = globalhelper.CreateAndWaitUntilDeploymentIsReady(dep, tsparams.Timeout)
-		if err != nil && strings.Contains(err.Error(), "not schedulable") {
-			Skip("This test cannot run because the pod is not schedulable due to insufficient resources")
-		}
-
-		Expect(err).ToNot(HaveOccurred())
-
-		By("Assert deployment does not have hostPath set")
-		runningDeployment, err := globalhelper.GetRunningDeployment(dep.Namespace, dep.Name)
-		Expect(err).ToNot(HaveOccurred())
-
-		for _, volume := range runningDeployment.Spec.Template.Spec.Volumes {
-			Expect(volume.HostPath).To(BeNil())
-		}
-
-		By("Start test")
-		err = globalhelper.LaunchTests(
-			tsparams.TestCaseNameAccessControlPodHostPath,
-			globalhelper.ConvertSpecNameToFileName(CurrentSpecReport().FullText()), randomReportDir, randomCertsuiteConfigDir)
-		Expect(err).ToNot(HaveOccurred())
-
-		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(
-			tsparams.TestCaseNameAccessControlPodHostPath,
-			globalparameters.TestCasePassed, randomReportDir)
-		Expect(err).ToNot(HaveOccurred())
-	})
-
-	// 53940
-	It("one deployment, one pod, HostPath set [negative]", func() {
+	It("one deployment, one pod, HostPath set [negative]", globalhelper.PolarionID("53940"), func() {
 		By("Define deployment with hostPath set to true")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -117,8 +80,7 @@ var _ = Describe("Access-control pod-host-path, ", Label("accesscontrol9"), func
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53941
-	It("two deployments, one pod each, HostPath not set", func() {
+	It("two deployments, one pod each, HostPath not set", globalhelper.PolarionID("53941"), func() {
 		By("Define deployments with hostPath set to false")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -163,8 +125,7 @@ var _ = Describe("Access-control pod-host-path, ", Label("accesscontrol9"), func
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53946
-	It("two deployments, one pod each, one HostPath set [negative]", func() {
+	It("two deployments, one pod each, one HostPath set [negative]", globalhelper.PolarionID("53946"), func() {
 		By("Define deployments with hostPath set to different values")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
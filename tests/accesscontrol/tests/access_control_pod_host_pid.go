@@ -39,38 +39,7 @@ var _ = Describe("Access-control pod-host-pid ", Label("accesscontrol9"), func()
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 53140
-	It("one deployment, one pod, HostPid false", func() {
-		By("Define deployment with hostPid set to false")
-		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
-		Expect(err).ToNot(HaveOccurred())
-
-		deployment.RedefineWithHostPid(dep, false)
-
-		By("Create deployment")
-		err = globalhelper.CreateAndWaitUntilDeploymentIsReady(dep, tsparams.Timeout)
-		Expect(err).ToNot(HaveOccurred())
-
-		By("Assert deployment has hostPid set to false")
-		runningDeployment, err := globalhelper.GetRunningDeployment(dep.Namespace, dep.Name)
-		Expect(err).ToNot(HaveOccurred())
-		Expect(runningDeployment.Spec.Template.Spec.HostPID).To(BeFalse())
-
-		By("Start test")
-		err = globalhelper.LaunchTests(
-			tsparams.TestCaseNameAccessControlPodHostPid,
-			globalhelper.ConvertSpecNameToFileName(CurrentSpecReport().FullText()), randomReportDir, randomCertsuiteConfigDir)
-		Expect(err).ToNot(HaveOccurred())
-
-		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(
-			tsparams.TestCaseNameAccessControlPodHostPid,
-			globalparameters.TestCasePassed, randomReportDir)
-		Expect(err).ToNot(HaveOccurred())
-	})
-
-	// 53141
-	It("one deployment, one pod, HostPid true [negative]", func() {
+	It("one deployment, one pod, HostPid true [negative]", globalhelper.PolarionID("53141"), func() {
 		By("Define deployment with hostPid set to true")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -93,14 +62,21 @@ var _ = Describe("Access-control pod-host-pid ", Label("accesscontrol9"), func()
 		Expect(err).ToNot(HaveOccurred())
 
 		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(
+		err = globalhelper.ValidateIfReportsAreValidWithReason(
 			tsparams.TestCaseNameAccessControlPodHostPid,
-			globalparameters.TestCaseFailed, randomReportDir)
+			globalparameters.TestCaseFailed, globalhelper.ReasonMatches(`(?i)host ?pid`), randomReportDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		By("Verify the deployment pod is the only non-compliant object")
+		checkDetails, err := globalhelper.GetTestCaseCheckDetails(tsparams.TestCaseNameAccessControlPodHostPid, randomReportDir)
 		Expect(err).ToNot(HaveOccurred())
+
+		nonCompliantPods := checkDetails.NonCompliant().OfType("Pod").InNamespace(randomNamespace).WithNamePrefix(dep.Name + "-")
+		Expect(nonCompliantPods).To(globalhelper.HaveReportObjectCount(1))
+		Expect(nonCompliantPods).To(globalhelper.HaveNoUnexpectedReportObjects())
 	})
 
-	// 53142
-	It("two deployments, one pod each, HostPids false", func() {
+	It("two deployments, one pod each, HostPids false", globalhelper.PolarionID("53142"), func() {
 		By("Define deployments with hostPid set to false")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -143,8 +119,7 @@ var _ = Describe("Access-control pod-host-pid ", Label("accesscontrol9"), func()
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 53143
-	It("two deployments, one pod each, one HostPid true [negative]", func() {
+	It("two deployments, one pod each, one HostPid true [negative]", globalhelper.PolarionID("53143"), func() {
 		By("Define deployments with hostPid set to different values")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -181,9 +156,19 @@ var _ = Describe("Access-control pod-host-pid ", Label("accesscontrol9"), func()
 		Expect(err).ToNot(HaveOccurred())
 
 		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(
+		err = globalhelper.ValidateIfReportsAreValidWithReason(
 			tsparams.TestCaseNameAccessControlPodHostPid,
-			globalparameters.TestCaseFailed, randomReportDir)
+			globalparameters.TestCaseFailed, globalhelper.ReasonMatches(`(?i)host ?pid`), randomReportDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		By("Verify only the pod of deployment 1 is non-compliant")
+		checkDetails, err := globalhelper.GetTestCaseCheckDetails(tsparams.TestCaseNameAccessControlPodHostPid, randomReportDir)
 		Expect(err).ToNot(HaveOccurred())
+
+		nonCompliantPods := checkDetails.NonCompliant().OfType("Pod").InNamespace(randomNamespace).WithNamePrefix(dep.Name + "-")
+		Expect(nonCompliantPods).To(globalhelper.HaveReportObjectCount(1))
+		Expect(nonCompliantPods).To(globalhelper.HaveNoUnexpectedReportObjects())
+		Expect(checkDetails.Compliant().OfType("Pod").InNamespace(randomNamespace).WithNamePrefix(dep2.Name + "-")).
+			To(globalhelper.HaveReportObjectCount(1))
 	})
 })
@@ -41,8 +41,7 @@ var _ = Describe("Access-control requests,", Label("accesscontrol10"), func() {
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 55021
-	It("one deployment, one container with all requests set", Serial, func() {
+	It("one deployment, one container with all requests set", Serial, globalhelper.PolarionID("55021"), func() {
 		By("Define deployment with requests set")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -72,8 +71,7 @@ var _ = Describe("Access-control requests,", Label("accesscontrol10"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55022
-	It("one deployment, one container with requests set", func() {
+	It("one deployment, one container with requests set", globalhelper.PolarionID("55022"), func() {
 		By("Define deployment with requests set")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -103,8 +101,7 @@ var _ = Describe("Access-control requests,", Label("accesscontrol10"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55023
-	It("one deployment, one container with no requests set [negative]", func() {
+	It("one deployment, one container with no requests set [negative]", globalhelper.PolarionID("55023"), func() {
 		By("Define deployment with no requests set")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -132,8 +129,7 @@ var _ = Describe("Access-control requests,", Label("accesscontrol10"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55025
-	It("one deployment, one container with CPU requests not set [negative]", func() {
+	It("one deployment, one container with CPU requests not set [negative]", globalhelper.PolarionID("55025"), func() {
 		By("Define deployment with CPU requests not set")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -170,8 +166,7 @@ var _ = Describe("Access-control requests,", Label("accesscontrol10"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55026
-	It("one deployment, one container with memory requests not set [negative]", func() {
+	It("one deployment, one container with memory requests not set [negative]", globalhelper.PolarionID("55026"), func() {
 		By("Define deployment with memory requests not set")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -208,8 +203,7 @@ var _ = Describe("Access-control requests,", Label("accesscontrol10"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55027
-	It("two deployments, one container each with all requests set", Serial, func() {
+	It("two deployments, one container each with all requests set", Serial, globalhelper.PolarionID("55027"), func() {
 		By("Define deployments with requests set")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -254,8 +248,8 @@ var _ = Describe("Access-control requests,", Label("accesscontrol10"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55028
-	It("two deployments, one container each, one with memory requests not set [negative]", Serial, func() {
+	It("two deployments, one container each, one with memory requests not set "+
+		"[negative]", Serial, globalhelper.PolarionID("55028"), func() {
 		By("Define deployments with memory requests not set on one")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
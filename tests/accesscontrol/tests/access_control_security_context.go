@@ -40,8 +40,7 @@ var _ = Describe("Access-control security-context,", Label("accesscontrol12", "o
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 63736
-	It("one deployment, one pod, one container, has allowed security context", func() {
+	It("one deployment, one pod, one container, has allowed security context", globalhelper.PolarionID("63736"), func() {
 		if globalhelper.IsKindCluster() {
 			Skip("Skip on kind cluster")
 		}
@@ -72,8 +71,7 @@ var _ = Describe("Access-control security-context,", Label("accesscontrol12", "o
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63737
-	It("one deployment, one pod, one container, has not allowed security context [negative]", func() {
+	It("one deployment, one pod, one container, has not allowed security context [negative]", globalhelper.PolarionID("63737"), func() {
 		By("Define deployment with not allowed security context")
 		dep, err := tshelper.DefineDeployment(1, 1, "acdeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -102,8 +100,7 @@ var _ = Describe("Access-control security-context,", Label("accesscontrol12", "o
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63738
-	It("two deployments, one pod each, one container each, both have allowed security context", func() {
+	It("two deployments, one pod each, one container each, both have allowed security context", globalhelper.PolarionID("63738"), func() {
 		if globalhelper.IsKindCluster() {
 			Skip("Skip on kind cluster")
 		}
@@ -136,8 +133,8 @@ var _ = Describe("Access-control security-context,", Label("accesscontrol12", "o
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63739
-	It("two deployments, one pod each, one container each, one has not allowed security context [negative]", func() {
+	It("two deployments, one pod each, one container each, one has not allowed security context "+
+		"[negative]", globalhelper.PolarionID("63739"), func() {
 		By("Define deployments with varying security contexts")
 		dep, err := tshelper.DefineDeployment(1, 1, "acdeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
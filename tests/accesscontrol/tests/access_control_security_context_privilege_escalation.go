@@ -39,8 +39,7 @@ var _ = Describe("Access-control security-context-privilege-escalation,", Label(
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 63992
-	It("one deployment, one pod, one container, does not allow privilege escalation", func() {
+	It("one deployment, one pod, one container, does not allow privilege escalation", globalhelper.PolarionID("63992"), func() {
 		By("Define deployment without privilege escalation")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -67,8 +66,7 @@ var _ = Describe("Access-control security-context-privilege-escalation,", Label(
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63993
-	It("one deployment, one pod, one container, does allow privilege escalation [negative]", func() {
+	It("one deployment, one pod, one container, does allow privilege escalation [negative]", globalhelper.PolarionID("63993"), func() {
 		By("Define deployment with privilege escalation")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -99,8 +97,8 @@ var _ = Describe("Access-control security-context-privilege-escalation,", Label(
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63994
-	It("two deployments, one pod each, one container each, does not allow privilege escalation", func() {
+	It("two deployments, one pod each, one container each, does not allow privilege "+
+		"escalation", globalhelper.PolarionID("63994"), func() {
 		By("Define deployments without privilege escalation")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -142,8 +140,8 @@ var _ = Describe("Access-control security-context-privilege-escalation,", Label(
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63995
-	It("two deployments, one pod each, one container each, one does allow privilege escalation [negative]", func() {
+	It("two deployments, one pod each, one container each, one does allow privilege escalation "+
+		"[negative]", globalhelper.PolarionID("63995"), func() {
 		By("Define deployments with varying privilege escalation capabilities")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
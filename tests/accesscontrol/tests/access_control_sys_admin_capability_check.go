@@ -40,8 +40,7 @@ var _ = Describe("Access-control sys-admin-capability-check,", Label("accesscont
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 63835
-	It("one deployment, one pod, one container, does not have sys admin capability", func() {
+	It("one deployment, one pod, one container, does not have sys admin capability", globalhelper.PolarionID("63835"), func() {
 		By("Define deployment without sys admin")
 		dep, err := tshelper.DefineDeployment(1, 1, "acdeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -102,8 +101,7 @@ var _ = Describe("Access-control sys-admin-capability-check,", Label("accesscont
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63836
-	It("one deployment, one pod, one container, does have sys admin capability [negative]", func() {
+	It("one deployment, one pod, one container, does have sys admin capability [negative]", globalhelper.PolarionID("63836"), func() {
 		By("Define deployment with sys admin")
 		dep, err := tshelper.DefineDeployment(1, 1, "acdeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -135,8 +133,7 @@ var _ = Describe("Access-control sys-admin-capability-check,", Label("accesscont
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63837
-	It("two deployments, one pod each, one container each, does not have sys admin capability", func() {
+	It("two deployments, one pod each, one container each, does not have sys admin capability", globalhelper.PolarionID("63837"), func() {
 		By("Define deployments without sys admin")
 		dep, err := tshelper.DefineDeployment(1, 1, "acdeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -220,8 +217,8 @@ var _ = Describe("Access-control sys-admin-capability-check,", Label("accesscont
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 63838
-	It("two deployments, one pod each, one container each, one does have sys admin capability [negative]", func() {
+	It("two deployments, one pod each, one container each, one does have sys admin capability "+
+		"[negative]", globalhelper.PolarionID("63838"), func() {
 		By("Define deployments with varying sys admin capabilities")
 		dep, err := tshelper.DefineDeployment(1, 1, "acdeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
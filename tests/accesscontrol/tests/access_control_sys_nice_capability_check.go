@@ -384,9 +384,9 @@ var _ = Describe("Access-control sys-nice_capability check, non-realtime kernel"
 		Expect(err).ToNot(HaveOccurred())
 
 		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(
+		err = globalhelper.ValidateIfReportsAreValidWithReason(
 			tsparams.TestCaseNameAccessControlRtSysNiceCapability,
-			globalparameters.TestCaseSkipped, randomReportDir)
+			globalparameters.TestCaseSkipped, globalhelper.SkipReasonNoContainers, randomReportDir)
 		Expect(err).ToNot(HaveOccurred())
 	})
 
@@ -40,8 +40,7 @@ var _ = Describe("Access-control sys-ptrace-capability ", Label("accesscontrol13
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 54657
-	It("one deployment, one pod, namespace sharing not enabled [skip]", func() {
+	It("one deployment, one pod, namespace sharing not enabled [skip]", globalhelper.PolarionID("54657"), func() {
 		By("Define deployment with shareProcessNamespace set to false")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -64,14 +63,14 @@ var _ = Describe("Access-control sys-ptrace-capability ", Label("accesscontrol13
 		Expect(err).ToNot(HaveOccurred())
 
 		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(
+		err = globalhelper.ValidateIfReportsAreValidWithReason(
 			tsparams.TestCaseNameAccessControlSysPtraceCapability,
-			globalparameters.TestCaseSkipped, randomReportDir)
+			globalparameters.TestCaseSkipped, globalhelper.SkipReasonNoSharedProcessNamespacePods, randomReportDir)
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54658
-	It("one deployment, one pod with namespace sharing enabled, one container with SYS_PTRACE allowed", func() {
+	It("one deployment, one pod with namespace sharing enabled, one container with SYS_PTRACE "+
+		"allowed", globalhelper.PolarionID("54658"), func() {
 		By("Define deployment with shareProcessNamespace set to true and SYS_PTRACE enabled")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -103,8 +102,8 @@ var _ = Describe("Access-control sys-ptrace-capability ", Label("accesscontrol13
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54659
-	It("one deployment, one pod with namespace sharing enabled, one container with SYS_PTRACE not allowed [negative]", func() {
+	It("one deployment, one pod with namespace sharing enabled, one container with SYS_PTRACE not allowed "+
+		"[negative]", globalhelper.PolarionID("54659"), func() {
 		By("Define deployment with shareProcessNamespace set to true and SYS_PTRACE not enabled")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -133,8 +132,8 @@ var _ = Describe("Access-control sys-ptrace-capability ", Label("accesscontrol13
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54660
-	It("two deployments, one pod each with namespace sharing enabled, one container each with SYS_PTRACE allowed", func() {
+	It("two deployments, one pod each with namespace sharing enabled, one container each with SYS_PTRACE "+
+		"allowed", globalhelper.PolarionID("54660"), func() {
 		By("Define deployments with shareProcessNamespace set to true and SYS_PTRACE enabled")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -183,8 +182,8 @@ var _ = Describe("Access-control sys-ptrace-capability ", Label("accesscontrol13
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54662
-	It("two deployments, one pod each with namespace sharing enabled, one container with SYS_PTRACE not allowed [negative]", func() {
+	It("two deployments, one pod each with namespace sharing enabled, one container with SYS_PTRACE not allowed "+
+		"[negative]", globalhelper.PolarionID("54662"), func() {
 		By("Define deployments with shareProcessNamespace set to true and one with SYS_PTRACE not enabled")
 		dep, err := tshelper.DefineDeployment(1, 1, "accesscontroldeployment1", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
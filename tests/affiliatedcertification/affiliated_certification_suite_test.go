@@ -19,6 +19,7 @@ import (
 )
 
 func TestAffiliatedCertification(t *testing.T) {
+	globalhelper.RegisterLaunchPolicies(tsparams.LaunchPolicies)
 	globalhelper.RunSuite(t, "CNFCert affiliated-certification tests")
 }
 
@@ -27,7 +28,7 @@ var (
 	helmDir                   string
 )
 
-var _ = SynchronizedBeforeSuite(func() {
+var _ = SynchronizedBeforeSuite(func() []byte {
 	if !globalhelper.IsKindCluster() {
 		By("Create temp directory for isolated helm installation")
 
@@ -97,7 +98,18 @@ var _ = SynchronizedBeforeSuite(func() {
 		err = globalhelper.CreateAndValidateCatalogSources(false)
 		Expect(err).ToNot(HaveOccurred(), "All necessary catalog sources are not available")
 	}
-}, func() {})
+
+	By("Check certsuite image")
+	Expect(globalhelper.ValidateCertsuiteImage()).To(Succeed(), "Error checking certsuite image")
+
+	By("Build certsuite binary")
+	certsuiteBinary, err := globalhelper.BuildCertsuiteBinary()
+	Expect(err).ToNot(HaveOccurred(), "Error building certsuite binary")
+
+	return []byte(certsuiteBinary)
+}, func(certsuiteBinary []byte) {
+	globalhelper.SetCertsuiteBinary(string(certsuiteBinary))
+})
 
 var _ = SynchronizedAfterSuite(func() {}, func() {
 	By(fmt.Sprintf("Remove %s namespace", tsparams.TestCertificationNameSpace))
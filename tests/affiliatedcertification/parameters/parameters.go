@@ -64,3 +64,9 @@ var (
 	UncertifiedOperatorPrefixSriov     = "sriov-fec"
 	UncertifiedOperatorFullSriov       = "sriov-fec.v1.2.1"
 )
+
+// LaunchPolicies sets the certsuite run timeout and attempts of the affiliated-certification test cases.
+var LaunchPolicies = map[string]globalparameters.LaunchPolicy{
+	// Certification checks query the Red Hat catalog, which may be slow to answer.
+	globalparameters.AffiliatedCertificationSuiteName: {Timeout: 45 * time.Minute},
+}
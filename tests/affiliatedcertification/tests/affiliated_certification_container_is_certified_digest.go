@@ -60,8 +60,7 @@ var _ = Describe("Affiliated-certification container-is-certified-digest,", Seri
 				randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 		})
 
-		// 66765
-		It("one container to test, container is certified digest", func() {
+		It("one container to test, container is certified digest", globalhelper.PolarionID("66765"), func() {
 			By("Define deployment with certified container")
 			dep := deployment.DefineDeployment("affiliated-cert-deployment", randomNamespace,
 				tsparams.CertifiedContainerURLNodeJs, tsparams.TestDeploymentLabels)
@@ -95,8 +94,7 @@ var _ = Describe("Affiliated-certification container-is-certified-digest,", Seri
 			Expect(err).ToNot(HaveOccurred())
 		})
 
-		// 66766
-		It("one container to test, container is not certified digest [negative]", func() {
+		It("one container to test, container is not certified digest [negative]", globalhelper.PolarionID("66766"), func() {
 			By("Define deployment with uncertified container")
 
 			dep := deployment.DefineDeployment("affiliated-cert-deployment", randomNamespace,
@@ -131,8 +129,7 @@ var _ = Describe("Affiliated-certification container-is-certified-digest,", Seri
 			Expect(err).ToNot(HaveOccurred())
 		})
 
-		// 66767
-		It("two containers to test, both are certified digest", func() {
+		It("two containers to test, both are certified digest", globalhelper.PolarionID("66767"), func() {
 			By("Define deployments with certified containers")
 			dep := deployment.DefineDeployment("affiliated-cert-deployment", randomNamespace,
 				tsparams.CertifiedContainerURLNodeJs, tsparams.TestDeploymentLabels)
@@ -175,8 +172,7 @@ var _ = Describe("Affiliated-certification container-is-certified-digest,", Seri
 			Expect(err).ToNot(HaveOccurred())
 		})
 
-		// 66768
-		It("two containers to test, one is certified, one is not digest [negative]", func() {
+		It("two containers to test, one is certified, one is not digest [negative]", globalhelper.PolarionID("66768"), func() {
 			// Note: This test uses container images, not operators, so it's not affected by
 			// the OCP 4.20 certified-operators catalog issue (see issue #1283)
 			By("Define deployments with different container certification statuses")
@@ -44,8 +44,7 @@ var _ = Describe("Affiliated-certification helm-version,", Serial, Label("affili
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 68120
-	It("installed helm version is certified", func() {
+	It("installed helm version is certified", globalhelper.PolarionID("68120"), func() {
 		By("Check if helm is installed")
 		_, err := globalhelper.RunShellCommand("helm version")
 
@@ -74,8 +73,7 @@ var _ = Describe("Affiliated-certification helm-version,", Serial, Label("affili
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 68121
-	It("installed helm version is not certified", func() {
+	It("installed helm version is not certified", globalhelper.PolarionID("68121"), func() {
 		By("Find and remove current helm binary")
 		helmPath, err := exec.LookPath("helm")
 		Expect(err).ToNot(HaveOccurred(), "helm not found in PATH")
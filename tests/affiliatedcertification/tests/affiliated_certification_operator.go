@@ -165,9 +165,8 @@ var _ = Describe("Affiliated-certification operator certification,", Serial, Lab
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 46699
 	It("one operator to test, operator is not in certified-operators organization [negative]",
-		func() {
+		globalhelper.PolarionID("46699"), func() {
 			deployUncertifiedOperator(uncertifiedOperator, randomNamespace)
 
 			By("Label operator to be certified")
@@ -204,9 +203,8 @@ var _ = Describe("Affiliated-certification operator certification,", Serial, Lab
 			Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 		})
 
-	// 46697
 	It("two operators to test, one is in certified-operators organization and its version is certified,"+
-		" one is not in certified-operators organization [negative]", func() {
+		" one is not in certified-operators organization [negative]", globalhelper.PolarionID("46697"), func() {
 		deployCertifiedOperator(certifiedOperator, randomNamespace)
 		deployUncertifiedOperator(uncertifiedOperator, randomNamespace)
 
@@ -250,9 +248,8 @@ var _ = Describe("Affiliated-certification operator certification,", Serial, Lab
 		Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 	})
 
-	// 46582
 	It("one operator to test, operator is in certified-operators organization"+
-		" and its version is certified", func() {
+		" and its version is certified", globalhelper.PolarionID("46582"), func() {
 		grafanaOperatorName := deployGrafanaOperator(randomNamespace)
 
 		By("Label operator to be certified")
@@ -284,9 +281,8 @@ var _ = Describe("Affiliated-certification operator certification,", Serial, Lab
 		Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 	})
 
-	// 46696
 	It("two operators to test, both are in certified-operators organization and their"+
-		" versions are certified", func() {
+		" versions are certified", globalhelper.PolarionID("46696"), func() {
 		grafanaOperatorName := deployGrafanaOperator(randomNamespace)
 		deployCertifiedOperator(certifiedOperator, randomNamespace)
 
@@ -329,8 +325,7 @@ var _ = Describe("Affiliated-certification operator certification,", Serial, Lab
 		Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 	})
 
-	// 46698
-	It("no operators are labeled for testing [negative]", func() {
+	It("no operators are labeled for testing [negative]", globalhelper.PolarionID("46698"), func() {
 		By("Start test")
 		err := globalhelper.LaunchTests(
 			tsparams.TestCaseOperatorAffiliatedCertName,
@@ -339,9 +334,9 @@ var _ = Describe("Affiliated-certification operator certification,", Serial, Lab
 			tsparams.TestCaseOperatorAffiliatedCertName+" test")
 
 		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(
+		err = globalhelper.ValidateIfReportsAreValidWithReason(
 			tsparams.TestCaseOperatorAffiliatedCertName,
-			globalparameters.TestCaseSkipped, randomReportDir)
+			globalparameters.TestCaseSkipped, globalhelper.SkipReasonNoOperators, randomReportDir)
 		Expect(err).ToNot(HaveOccurred(), "Error validating test reports")
 	})
 })
@@ -0,0 +1,162 @@
+package globalhelper
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	klog "k8s.io/klog/v2"
+)
+
+// LaunchBatch runs certsuite once for all the test cases registered by specs sharing
+// the same namespace and certsuite configuration. Each spec then validates its own
+// test case from the shared claim.
+//
+// Test cases are registered when the container is built or in a BeforeAll node, and the
+// batch is launched by the first call to Launch, usually in the BeforeAll node of an Ordered
+// container. The BeforeAll node resets the batch first, so a flake retry of the container
+// runs certsuite again. ValidateIfReportsAreValid fails when the batch was not launched:
+//
+//	batch := globalhelper.NewLaunchBatch(tsparams.TestCaseNameA, tsparams.TestCaseNameB)
+//
+//	BeforeAll(func() {
+//		batch.Reset()
+//		...
+//		Expect(batch.Launch(tcNameForReport, randomReportDir, randomCertsuiteConfigDir)).To(Succeed())
+//	})
+//
+//	It("...", func() {
+//		Expect(batch.ValidateIfReportsAreValid(tsparams.TestCaseNameA, globalparameters.TestCasePassed)).To(Succeed())
+//	})
+type LaunchBatch struct {
+	lock      sync.Mutex
+	tcNames   []string
+	reportDir string
+	launched  bool
+	launchErr error
+}
+
+// NewLaunchBatch returns a batch with the given test cases registered.
+func NewLaunchBatch(tcNames ...string) *LaunchBatch {
+	batch := &LaunchBatch{}
+	batch.tcNames = appendUnique(batch.tcNames, tcNames...)
+
+	return batch
+}
+
+// Register adds test cases to the batch. It fails once the batch has been launched.
+func (b *LaunchBatch) Register(tcNames ...string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.launched {
+		return fmt.Errorf("can not register %v: batch %q was already launched", tcNames, b.labelFilter())
+	}
+
+	b.tcNames = appendUnique(b.tcNames, tcNames...)
+
+	return nil
+}
+
+// Reset forgets the result of the previous launch, so the next call to Launch runs certsuite
+// again. Registered test cases are kept.
+func (b *LaunchBatch) Reset() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.launched = false
+	b.launchErr = nil
+	b.reportDir = ""
+}
+
+// LabelFilter returns the certsuite label expression selecting every registered test case.
+func (b *LaunchBatch) LabelFilter() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.labelFilter()
+}
+
+// Launch runs certsuite for all registered test cases. Only the first call runs certsuite,
+// later calls return the result of the first one.
+func (b *LaunchBatch) Launch(tcNameForReport, reportDir, configDir string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.launched {
+		return b.launchErr
+	}
+
+	b.launched = true
+	b.reportDir = reportDir
+
+	if len(b.tcNames) == 0 {
+		b.launchErr = fmt.Errorf("no test cases registered in the batch")
+
+		return b.launchErr
+	}
+
+	klog.V(5).Infof("Launching batch of %d test cases: %s", len(b.tcNames), b.labelFilter())
+
+	b.launchErr = LaunchTests(b.labelFilter(), tcNameForReport, reportDir, configDir)
+
+	return b.launchErr
+}
+
+// ValidateIfReportsAreValid checks the state of a registered test case in the shared claim.
+func (b *LaunchBatch) ValidateIfReportsAreValid(tcName string, tcExpectedStatus string) error {
+	reportDir, err := b.launchedReportDir(tcName)
+	if err != nil {
+		return err
+	}
+
+	return ValidateIfReportsAreValid(tcName, tcExpectedStatus, reportDir)
+}
+
+// ValidateIfReportsAreValidWithReason checks the state of a registered test case and its reason in
+// the shared claim.
+func (b *LaunchBatch) ValidateIfReportsAreValidWithReason(tcName string, tcExpectedStatus string, reason ReasonMatcher) error {
+	reportDir, err := b.launchedReportDir(tcName)
+	if err != nil {
+		return err
+	}
+
+	return ValidateIfReportsAreValidWithReason(tcName, tcExpectedStatus, reason, reportDir)
+}
+
+// launchedReportDir returns the report directory of the batch run of a registered test case.
+func (b *LaunchBatch) launchedReportDir(tcName string) (string, error) {
+	b.lock.Lock()
+	launched, launchErr, reportDir := b.launched, b.launchErr, b.reportDir
+	registered := slices.Contains(b.tcNames, tcName)
+	b.lock.Unlock()
+
+	if !registered {
+		return "", fmt.Errorf("test case %q is not registered in the batch", tcName)
+	}
+
+	if !launched {
+		return "", fmt.Errorf("batch containing test case %q was not launched", tcName)
+	}
+
+	if launchErr != nil {
+		return "", fmt.Errorf("batch containing test case %q failed to launch: %w", tcName, launchErr)
+	}
+
+	return reportDir, nil
+}
+
+func (b *LaunchBatch) labelFilter() string {
+	return strings.Join(b.tcNames, " || ")
+}
+
+func appendUnique(list []string, elements ...string) []string {
+	for _, element := range elements {
+		if !slices.Contains(list, element) {
+			list = append(list, element)
+		}
+	}
+
+	return list
+}
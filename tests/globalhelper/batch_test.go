@@ -0,0 +1,58 @@
+package globalhelper
+
+import (
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLaunchBatch(t *testing.T) {
+	originalConf := conf
+
+	defer func() { conf = originalConf }()
+
+	launcher := &FakeLauncher{
+		Results: map[string]string{"access-control-pod-host-pid": globalparameters.TestCaseFailed},
+	}
+	RegisterLauncher("batch-test", launcher)
+
+	conf = &config.Config{}
+	conf.General.Launcher = "batch-test"
+	conf.General.ReportDirAbsPath = t.TempDir()
+
+	batch := NewLaunchBatch("access-control-pod-host-pid", "access-control-pod-host-ipc", "access-control-pod-host-pid")
+	assert.Nil(t, batch.Register("access-control-pod-host-network"))
+	assert.Equal(t, "access-control-pod-host-pid || access-control-pod-host-ipc || access-control-pod-host-network",
+		batch.LabelFilter())
+
+	assert.NotNil(t, batch.ValidateIfReportsAreValid("access-control-pod-host-ipc", globalparameters.TestCasePassed))
+
+	reportDir := t.TempDir()
+	assert.Nil(t, batch.Launch("host_namespaces", reportDir, t.TempDir()))
+	assert.Nil(t, batch.Launch("host_namespaces", reportDir, t.TempDir()))
+	assert.Len(t, launcher.Requests(), 1)
+	assert.Equal(t, batch.LabelFilter(), launcher.Requests()[0].LabelFilter)
+
+	assert.NotNil(t, batch.Register("access-control-pod-host-path"))
+
+	assert.Nil(t, batch.ValidateIfReportsAreValid("access-control-pod-host-pid", globalparameters.TestCaseFailed))
+	assert.Nil(t, batch.ValidateIfReportsAreValid("access-control-pod-host-ipc", globalparameters.TestCasePassed))
+	assert.Nil(t, batch.ValidateIfReportsAreValid("access-control-pod-host-network", globalparameters.TestCasePassed))
+	assert.NotNil(t, batch.ValidateIfReportsAreValid("access-control-pod-host-path", globalparameters.TestCasePassed))
+
+	batch.Reset()
+	assert.NotNil(t, batch.ValidateIfReportsAreValid("access-control-pod-host-ipc", globalparameters.TestCasePassed))
+
+	retryReportDir := t.TempDir()
+	assert.Nil(t, batch.Launch("host_namespaces", retryReportDir, t.TempDir()))
+	assert.Len(t, launcher.Requests(), 2)
+	assert.Equal(t, retryReportDir, launcher.Requests()[1].ReportDir)
+	assert.Nil(t, batch.ValidateIfReportsAreValid("access-control-pod-host-ipc", globalparameters.TestCasePassed))
+}
+
+func TestLaunchBatchEmpty(t *testing.T) {
+	batch := NewLaunchBatch()
+	assert.NotNil(t, batch.Launch("empty", t.TempDir(), t.TempDir()))
+}
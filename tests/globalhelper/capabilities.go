@@ -0,0 +1,505 @@
+package globalhelper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klog "k8s.io/klog/v2"
+)
+
+// Cluster capabilities specs can require with RequiresCapability.
+const (
+	CapabilityOpenShift           = "openshift"
+	CapabilityKind                = "kind"
+	CapabilityCRC                 = "crc"
+	CapabilityMCO                 = "mco"
+	CapabilityPerformanceProfiles = "performance-profiles"
+	CapabilityStaticCPUManager    = "static-cpu-manager"
+	CapabilityMultus              = "multus"
+	CapabilityIPv6                = "ipv6"
+	CapabilityDualStack           = "dual-stack"
+	CapabilityWorkerNodes         = "worker-nodes"
+	// CapabilityHugepages is set when a CNF worker node has hugepages of any size. The
+	// "hugepages-<size>" capabilities, e.g. CapabilityHugepages2Mi, are set per size.
+	CapabilityHugepages           = "hugepages"
+	CapabilityHugepages2Mi        = "hugepages-2Mi"
+	CapabilityHugepages1Gi        = "hugepages-1Gi"
+	CapabilityRTKernel            = "rt-kernel"
+	CapabilityDefaultStorageClass = "default-storage-class"
+	CapabilityOLM                 = "olm"
+	CapabilityMarketplace         = "marketplace"
+)
+
+const (
+	// CapabilityLabelKey is the key of the labels added by RequiresCapability.
+	CapabilityLabelKey = "requires"
+	// CapabilitiesFileName is the file, in the report directory, caching the static capabilities.
+	CapabilitiesFileName = "cluster-capabilities.json"
+	// capabilitiesMaxAge is how long cached static capabilities are used before probing the cluster
+	// again.
+	capabilitiesMaxAge = time.Hour
+
+	multusAPIGroup          = "k8s.cni.cncf.io"
+	olmAPIGroup             = "operators.coreos.com"
+	hugepagesResourcePrefix = "hugepages-"
+	// defaultStorageClassAnnotation marks the default storage class.
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+)
+
+// ClusterCapabilities are the features of the cluster. The static ones, e.g. the platform, the
+// kernel or the node labels, are probed once per run. The dynamic ones, e.g. the MCO health, are
+// probed by each suite.
+type ClusterCapabilities struct {
+	// Server is the API server the capabilities were probed on.
+	Server   string    `json:"server"`
+	ProbedAt time.Time `json:"probedAt"`
+	// Version is the OpenShift version, or the Kubernetes one.
+	Version        string          `json:"version,omitempty"`
+	HugepagesSizes []string        `json:"hugepagesSizes,omitempty"`
+	Capabilities   map[string]bool `json:"capabilities"`
+	// Reasons tells why the missing capabilities could not be found, e.g. a probe error.
+	Reasons map[string]string `json:"reasons,omitempty"`
+}
+
+// Has returns true when the cluster has the capability.
+func (c *ClusterCapabilities) Has(name string) bool {
+	return c.Capabilities[name]
+}
+
+// Missing returns the capabilities of the list the cluster does not have.
+func (c *ClusterCapabilities) Missing(names ...string) []string {
+	var missing []string
+
+	for _, name := range names {
+		if !c.Has(name) {
+			missing = append(missing, name)
+		}
+	}
+
+	return missing
+}
+
+// describe returns the capabilities with the reason they are missing.
+func (c *ClusterCapabilities) describe(names []string) string {
+	descriptions := make([]string, 0, len(names))
+
+	for _, name := range names {
+		description := name
+
+		if _, probed := c.Capabilities[name]; !probed {
+			description += " (unknown capability)"
+		} else if reason := c.Reasons[name]; reason != "" {
+			description += " (" + reason + ")"
+		}
+
+		descriptions = append(descriptions, description)
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+func (c *ClusterCapabilities) set(name string, value bool, err error) {
+	c.Capabilities[name] = value
+
+	if err != nil {
+		c.Reasons[name] = err.Error()
+	}
+}
+
+// clusterFacts are the cluster objects most probes need, read once.
+type clusterFacts struct {
+	nodes        []corev1.Node
+	nodesErr     error
+	apiGroups    []string
+	apiGroupsErr error
+}
+
+// servesAPIGroup returns true when the cluster serves the API group.
+func (f *clusterFacts) servesAPIGroup(group string) (bool, error) {
+	return slices.Contains(f.apiGroups, group), f.apiGroupsErr
+}
+
+type capabilityProbe struct {
+	name  string
+	probe func(facts *clusterFacts) (bool, error)
+	// dynamic probes check a state the suites or the cluster may change, they are never cached.
+	dynamic bool
+}
+
+var (
+	capabilityProbesLock sync.Mutex
+	// capabilityProbes are the probes of the capabilities not derived from the nodes.
+	capabilityProbes = []capabilityProbe{
+		{CapabilityOpenShift, func(facts *clusterFacts) (bool, error) { return facts.servesAPIGroup(openShiftConfigAPIGroup) }, false},
+		{CapabilityMultus, func(facts *clusterFacts) (bool, error) { return facts.servesAPIGroup(multusAPIGroup) }, false},
+		{CapabilityOLM, func(facts *clusterFacts) (bool, error) { return facts.servesAPIGroup(olmAPIGroup) }, false},
+		{CapabilityKind, func(*clusterFacts) (bool, error) { return IsKindCluster(), nil }, false},
+		{CapabilityCRC, func(*clusterFacts) (bool, error) { return IsCRCCluster(), nil }, false},
+		{CapabilityMCO, func(*clusterFacts) (bool, error) { return IsMCOHealthy() }, true},
+		{CapabilityPerformanceProfiles, func(*clusterFacts) (bool, error) { return HasPerformanceProfiles() }, true},
+		{CapabilityStaticCPUManager, func(*clusterFacts) (bool, error) { return HasStaticCPUManagerPolicy() }, true},
+		{CapabilityDefaultStorageClass, func(*clusterFacts) (bool, error) { return hasDefaultStorageClass() }, true},
+		{CapabilityMarketplace, func(*clusterFacts) (bool, error) { return hasMarketplaceCatalogs() }, true},
+	}
+
+	clusterCapabilitiesLock sync.Mutex
+	clusterCapabilities     *ClusterCapabilities
+)
+
+// RegisterCapabilityProbe registers the probe of a capability, replacing the probe previously
+// registered with the same name. It must be called before the capabilities are first probed. The
+// registered capabilities are dynamic, probed by each suite.
+func RegisterCapabilityProbe(name string, probe func() (bool, error)) {
+	capabilityProbesLock.Lock()
+	defer capabilityProbesLock.Unlock()
+
+	capabilityProbes = slices.DeleteFunc(capabilityProbes, func(p capabilityProbe) bool { return p.name == name })
+	capabilityProbes = append(capabilityProbes, capabilityProbe{name, func(*clusterFacts) (bool, error) { return probe() }, true})
+}
+
+// GetClusterCapabilities returns the capabilities of the cluster. They are probed on the first call.
+// The static ones are cached in the report directory, where the other suites of the run read them,
+// the dynamic ones are probed again by each suite.
+func GetClusterCapabilities() *ClusterCapabilities {
+	clusterCapabilitiesLock.Lock()
+	defer clusterCapabilitiesLock.Unlock()
+
+	if clusterCapabilities != nil {
+		return clusterCapabilities
+	}
+
+	server := GetAPIClient().Config.Host
+	cachePath := filepath.Join(GetConfiguration().General.ReportDirAbsPath, CapabilitiesFileName)
+	facts := readClusterFacts()
+
+	capabilities, err := readClusterCapabilities(cachePath)
+	if err == nil && capabilities.Server == server && time.Since(capabilities.ProbedAt) < capabilitiesMaxAge {
+		klog.V(5).Infof("Using the static cluster capabilities cached in %s", cachePath)
+	} else {
+		capabilities = probeStaticCapabilities(facts)
+
+		err = writeClusterCapabilities(cachePath, capabilities)
+		if err != nil {
+			klog.ErrorS(err, "failed to cache the cluster capabilities", "file", cachePath)
+		}
+	}
+
+	probeDynamicCapabilities(capabilities, facts)
+
+	klog.V(4).Infof("Cluster capabilities: %v", capabilities.Capabilities)
+
+	clusterCapabilities = capabilities
+
+	return clusterCapabilities
+}
+
+// HasCapability returns true when the cluster has the capability.
+func HasCapability(name string) bool {
+	return GetClusterCapabilities().Has(name)
+}
+
+// ProbeClusterCapabilities probes every registered capability on the cluster, static and dynamic.
+func ProbeClusterCapabilities() *ClusterCapabilities {
+	facts := readClusterFacts()
+
+	capabilities := probeStaticCapabilities(facts)
+	probeDynamicCapabilities(capabilities, facts)
+
+	klog.V(4).Infof("Cluster capabilities: %v", capabilities.Capabilities)
+
+	return capabilities
+}
+
+// readClusterFacts reads the nodes and the API groups of the cluster.
+func readClusterFacts() *clusterFacts {
+	facts := &clusterFacts{}
+
+	nodes, err := GetAPIClient().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err == nil {
+		facts.nodes = nodes.Items
+	} else {
+		facts.nodesErr = fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	groups, err := GetAPIClient().ServerGroups()
+	if err == nil {
+		for _, group := range groups.Groups {
+			facts.apiGroups = append(facts.apiGroups, group.Name)
+		}
+	} else {
+		facts.apiGroupsErr = fmt.Errorf("failed to list API groups: %w", err)
+	}
+
+	return facts
+}
+
+// probeStaticCapabilities probes the capabilities that do not change during a run.
+func probeStaticCapabilities(facts *clusterFacts) *ClusterCapabilities {
+	capabilities := &ClusterCapabilities{
+		Server:       GetAPIClient().Config.Host,
+		ProbedAt:     time.Now().UTC(),
+		Version:      probeClusterVersion(),
+		Capabilities: map[string]bool{},
+		Reasons:      map[string]string{},
+	}
+
+	runCapabilityProbes(capabilities, facts, false)
+	setNodeCapabilities(capabilities, facts.nodes, facts.nodesErr)
+
+	return capabilities
+}
+
+// probeDynamicCapabilities probes, in the given capabilities, the capabilities the suites or the
+// cluster may change.
+func probeDynamicCapabilities(capabilities *ClusterCapabilities, facts *clusterFacts) {
+	if capabilities.Reasons == nil {
+		capabilities.Reasons = map[string]string{}
+	}
+
+	runCapabilityProbes(capabilities, facts, true)
+	setHugepagesCapabilities(capabilities, facts.nodes, GetConfiguration().General.CnfNodeLabel, facts.nodesErr)
+}
+
+func runCapabilityProbes(capabilities *ClusterCapabilities, facts *clusterFacts, dynamic bool) {
+	capabilityProbesLock.Lock()
+	probes := slices.Clone(capabilityProbes)
+	capabilityProbesLock.Unlock()
+
+	for _, probe := range probes {
+		if probe.dynamic != dynamic {
+			continue
+		}
+
+		value, err := probe.probe(facts)
+		capabilities.set(probe.name, value, err)
+	}
+}
+
+// setNodeCapabilities sets the static capabilities derived from the nodes: IP families, real time
+// kernel and worker nodes.
+func setNodeCapabilities(capabilities *ClusterCapabilities, nodes []corev1.Node, err error) {
+	var hasIPv4, hasIPv6, hasRTKernel, hasWorkers bool
+
+	for _, node := range nodes {
+		for _, address := range node.Status.Addresses {
+			if address.Type != corev1.NodeInternalIP {
+				continue
+			}
+
+			if ip := net.ParseIP(address.Address); ip != nil && ip.To4() == nil {
+				hasIPv6 = true
+			} else if ip != nil {
+				hasIPv4 = true
+			}
+		}
+
+		// Real time kernels have a "rt" release, e.g. 5.14.0-284.30.1.rt14.315.el9_2.x86_64.
+		if strings.Contains(node.Status.NodeInfo.KernelVersion, ".rt") {
+			hasRTKernel = true
+		}
+
+		if _, isWorker := node.Labels["node-role.kubernetes.io/worker"]; isWorker {
+			hasWorkers = true
+		}
+	}
+
+	capabilities.set(CapabilityIPv6, hasIPv6, err)
+	capabilities.set(CapabilityDualStack, hasIPv4 && hasIPv6, err)
+	capabilities.set(CapabilityRTKernel, hasRTKernel, err)
+	capabilities.set(CapabilityWorkerNodes, hasWorkers, err)
+}
+
+// setHugepagesCapabilities sets the hugepages capabilities of the CNF nodes, which the machine
+// configs of the suites change.
+func setHugepagesCapabilities(capabilities *ClusterCapabilities, nodes []corev1.Node, cnfNodeLabel string, err error) {
+	sizes := map[string]bool{}
+
+	for _, node := range nodes {
+		if _, isCnf := node.Labels[cnfNodeLabel]; !isCnf {
+			continue
+		}
+
+		for resourceName, quantity := range node.Status.Capacity {
+			if strings.HasPrefix(string(resourceName), hugepagesResourcePrefix) && !quantity.IsZero() {
+				sizes[strings.TrimPrefix(string(resourceName), hugepagesResourcePrefix)] = true
+			}
+		}
+	}
+
+	capabilities.set(CapabilityHugepages, len(sizes) > 0, err)
+
+	// The sizes specs usually require are set even when missing.
+	for _, name := range []string{CapabilityHugepages2Mi, CapabilityHugepages1Gi} {
+		capabilities.set(name, false, err)
+	}
+
+	capabilities.HugepagesSizes = nil
+
+	for size := range sizes {
+		capabilities.set(hugepagesResourcePrefix+size, true, nil)
+		capabilities.HugepagesSizes = append(capabilities.HugepagesSizes, size)
+	}
+
+	sort.Strings(capabilities.HugepagesSizes)
+}
+
+func probeClusterVersion() string {
+	if version, err := GetClusterVersion(); err == nil {
+		return version
+	}
+
+	serverVersion, err := GetAPIClient().ServerVersion()
+	if err != nil {
+		return ""
+	}
+
+	return serverVersion.GitVersion
+}
+
+func hasDefaultStorageClass() (bool, error) {
+	storageClasses, err := GetAPIClient().K8sClient.StorageV1().StorageClasses().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to list storage classes: %w", err)
+	}
+
+	for _, storageClass := range storageClasses.Items {
+		if storageClass.Annotations[defaultStorageClassAnnotation] == "true" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func hasMarketplaceCatalogs() (bool, error) {
+	catalogSources, err := GetAPIClient().CatalogSources(CatalogSourceNamespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to list catalog sources: %w", err)
+	}
+
+	return len(catalogSources.Items) > 0, nil
+}
+
+func readClusterCapabilities(cachePath string) (*ClusterCapabilities, error) {
+	content, err := os.ReadFile(cachePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cluster capabilities: %w", err)
+	}
+
+	capabilities := &ClusterCapabilities{}
+
+	err = json.Unmarshal(content, capabilities)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cluster capabilities %s: %w", cachePath, err)
+	}
+
+	if capabilities.Capabilities == nil {
+		return nil, errors.New("cluster capabilities file has no capabilities")
+	}
+
+	return capabilities, nil
+}
+
+// writeClusterCapabilities writes the capabilities through a temporary file, since the parallel
+// ginkgo processes may write them at the same time.
+func writeClusterCapabilities(cachePath string, capabilities *ClusterCapabilities) error {
+	content, err := json.MarshalIndent(capabilities, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cluster capabilities: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(cachePath), globalparameters.DirPermissions)
+	if err != nil {
+		return fmt.Errorf("failed to create directory of %s: %w", cachePath, err)
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(cachePath), CapabilitiesFileName+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create cluster capabilities file: %w", err)
+	}
+
+	_, err = tmpFile.Write(content)
+	closeErr := tmpFile.Close()
+
+	if err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(tmpFile.Name(), cachePath)
+	}
+
+	if err != nil {
+		_ = os.Remove(tmpFile.Name())
+
+		return fmt.Errorf("failed to write cluster capabilities file: %w", err)
+	}
+
+	return nil
+}
+
+// RequiresCapability labels a spec or a container with the cluster capabilities it needs, as
+// "requires:<capability>" labels. The specs of a suite run with RunSuite are skipped when the
+// cluster misses one of them.
+//
+//	Describe("platform-alteration-hugepages-2m-only", globalhelper.RequiresCapability(
+//		globalhelper.CapabilityMCO, globalhelper.CapabilityHugepages2Mi), func() {
+func RequiresCapability(names ...string) Labels {
+	labels := make(Labels, 0, len(names))
+	for _, name := range names {
+		labels = append(labels, CapabilityLabelKey+":"+name)
+	}
+
+	return labels
+}
+
+// GetRequiredCapabilities returns the capabilities required by the labels of a spec.
+func GetRequiredCapabilities(labels []string) []string {
+	var names []string
+
+	for _, label := range labels {
+		key, name, found := strings.Cut(label, ":")
+		if found && strings.TrimSpace(key) == CapabilityLabelKey {
+			names = append(names, strings.TrimSpace(name))
+		}
+	}
+
+	return names
+}
+
+// missingCapabilitiesMessage returns the skip message of a spec requiring capabilities the cluster
+// does not have, empty when it has all of them.
+func missingCapabilitiesMessage(capabilities *ClusterCapabilities, required []string) string {
+	missing := capabilities.Missing(required...)
+	if len(missing) == 0 {
+		return ""
+	}
+
+	return "Cluster is missing the capabilities required by the spec: " + capabilities.describe(missing)
+}
+
+// skipUnlessCapable skips the current spec when the cluster misses a capability it requires.
+func skipUnlessCapable() {
+	required := GetRequiredCapabilities(CurrentSpecReport().Labels())
+	if len(required) == 0 {
+		return
+	}
+
+	if message := missingCapabilitiesMessage(GetClusterCapabilities(), required); message != "" {
+		Skip(message)
+	}
+}
@@ -0,0 +1,160 @@
+package globalhelper
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testCnfNodeLabel = "node-role.kubernetes.io/worker-cnf"
+
+func newCapabilitiesTestNode(labels map[string]string, kernel string, addresses ...string) corev1.Node {
+	node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: labels}}
+	node.Status.NodeInfo.KernelVersion = kernel
+
+	for _, address := range addresses {
+		node.Status.Addresses = append(node.Status.Addresses,
+			corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: address})
+	}
+
+	return node
+}
+
+func newTestCapabilities() *ClusterCapabilities {
+	return &ClusterCapabilities{Capabilities: map[string]bool{}, Reasons: map[string]string{}}
+}
+
+func TestSetNodeCapabilities(t *testing.T) {
+	cnfNode := newCapabilitiesTestNode(map[string]string{testCnfNodeLabel: "", "node-role.kubernetes.io/worker": ""},
+		"5.14.0-284.30.1.rt14.315.el9_2.x86_64", "10.0.0.1", "fd00::1")
+	cnfNode.Status.Capacity = corev1.ResourceList{
+		"hugepages-1Gi": resource.MustParse("4Gi"),
+		"hugepages-2Mi": resource.MustParse("0"),
+	}
+
+	// Hugepages of nodes other than the CNF ones are ignored.
+	otherNode := newCapabilitiesTestNode(map[string]string{}, "5.14.0-284.30.1.el9_2.x86_64", "10.0.0.2")
+	otherNode.Status.Capacity = corev1.ResourceList{"hugepages-2Mi": resource.MustParse("1Gi")}
+
+	capabilities := newTestCapabilities()
+	setNodeCapabilities(capabilities, []corev1.Node{cnfNode, otherNode}, nil)
+	setHugepagesCapabilities(capabilities, []corev1.Node{cnfNode, otherNode}, testCnfNodeLabel, nil)
+
+	assert.Equal(t, map[string]bool{
+		CapabilityIPv6:         true,
+		CapabilityDualStack:    true,
+		CapabilityRTKernel:     true,
+		CapabilityWorkerNodes:  true,
+		CapabilityHugepages:    true,
+		CapabilityHugepages1Gi: true,
+		CapabilityHugepages2Mi: false,
+	}, capabilities.Capabilities)
+	assert.Equal(t, []string{"1Gi"}, capabilities.HugepagesSizes)
+}
+
+func TestSetNodeCapabilitiesError(t *testing.T) {
+	capabilities := newTestCapabilities()
+	setNodeCapabilities(capabilities, nil, errors.New("failed to list nodes"))
+	setHugepagesCapabilities(capabilities, nil, testCnfNodeLabel, errors.New("failed to list nodes"))
+
+	assert.False(t, capabilities.Has(CapabilityIPv6))
+	assert.Equal(t, "failed to list nodes", capabilities.Reasons[CapabilityHugepages2Mi])
+}
+
+func TestRequiresCapability(t *testing.T) {
+	labels := RequiresCapability(CapabilityMultus, CapabilityIPv6)
+	assert.Equal(t, []string{"requires:multus", "requires:ipv6"}, []string(labels))
+
+	assert.Equal(t, []string{CapabilityMultus, CapabilityIPv6},
+		GetRequiredCapabilities(append([]string{"networking", "polarion:12345"}, labels...)))
+	assert.Empty(t, GetRequiredCapabilities([]string{"networking"}))
+}
+
+func TestMissingCapabilitiesMessage(t *testing.T) {
+	capabilities := newTestCapabilities()
+	capabilities.set(CapabilityMultus, true, nil)
+	capabilities.set(CapabilityMCO, false, errors.New("forbidden"))
+	capabilities.set(CapabilityIPv6, false, nil)
+
+	assert.Equal(t, "", missingCapabilitiesMessage(capabilities, []string{CapabilityMultus}))
+	assert.Equal(t, "Cluster is missing the capabilities required by the spec: mco (forbidden), ipv6, gpu (unknown capability)",
+		missingCapabilitiesMessage(capabilities, []string{CapabilityMultus, CapabilityMCO, CapabilityIPv6, "gpu"}))
+}
+
+func TestClusterCapabilitiesCache(t *testing.T) {
+	cachePath := filepath.Join(t.TempDir(), "reports", CapabilitiesFileName)
+
+	_, err := readClusterCapabilities(cachePath)
+	assert.NotNil(t, err)
+
+	capabilities := newTestCapabilities()
+	capabilities.Server = "https://api.cluster:6443"
+	capabilities.ProbedAt = time.Now().UTC().Truncate(time.Second)
+	capabilities.Version = "4.18.1"
+	capabilities.set(CapabilityOpenShift, true, nil)
+
+	assert.Nil(t, writeClusterCapabilities(cachePath, capabilities))
+
+	cached, err := readClusterCapabilities(cachePath)
+	assert.Nil(t, err)
+	assert.Equal(t, capabilities.Capabilities, cached.Capabilities)
+	assert.Equal(t, capabilities.Server, cached.Server)
+	assert.True(t, capabilities.ProbedAt.Equal(cached.ProbedAt))
+
+	assert.Nil(t, os.WriteFile(cachePath, []byte("{}"), 0600))
+
+	_, err = readClusterCapabilities(cachePath)
+	assert.ErrorContains(t, err, "has no capabilities")
+}
+
+func TestRunCapabilityProbes(t *testing.T) {
+	originalProbes := slices.Clone(capabilityProbes)
+
+	defer func() { capabilityProbes = originalProbes }()
+
+	capabilityProbes = []capabilityProbe{
+		{CapabilityMultus, func(*clusterFacts) (bool, error) { return true, nil }, false},
+		{CapabilityMCO, func(*clusterFacts) (bool, error) { return false, errors.New("worker is degraded") }, true},
+	}
+	RegisterCapabilityProbe("sriov", func() (bool, error) { return true, nil })
+
+	// Only the static capabilities are cached.
+	capabilities := newTestCapabilities()
+	runCapabilityProbes(capabilities, &clusterFacts{}, false)
+	assert.Equal(t, map[string]bool{CapabilityMultus: true}, capabilities.Capabilities)
+
+	runCapabilityProbes(capabilities, &clusterFacts{}, true)
+	assert.Equal(t, map[string]bool{CapabilityMultus: true, CapabilityMCO: false, "sriov": true}, capabilities.Capabilities)
+	assert.Equal(t, "worker is degraded", capabilities.Reasons[CapabilityMCO])
+}
+
+func TestRegisterCapabilityProbe(t *testing.T) {
+	originalProbes := slices.Clone(capabilityProbes)
+
+	defer func() { capabilityProbes = originalProbes }()
+
+	RegisterCapabilityProbe("sriov", func() (bool, error) { return true, nil })
+	RegisterCapabilityProbe("sriov", func() (bool, error) { return false, errors.New("no sriov operator") })
+
+	found := 0
+
+	for _, probe := range capabilityProbes {
+		if probe.name == "sriov" {
+			found++
+
+			value, err := probe.probe(&clusterFacts{})
+			assert.False(t, value)
+			assert.ErrorContains(t, err, "no sriov operator")
+		}
+	}
+
+	assert.Equal(t, 1, found)
+}
@@ -24,9 +24,10 @@ func ValidateCatalogSources() error {
 	return validateCatalogSources(GetAPIClient().OperatorsV1alpha1Interface)
 }
 
-func validateCatalogSources(opclient v1alpha1typed.OperatorsV1alpha1Interface) error {
-	requiredCatalogSources := []string{"certified-operators", "community-operators"}
+// requiredCatalogSources are the catalog sources the operator specs install their operators from.
+var requiredCatalogSources = []string{"certified-operators", "community-operators"}
 
+func validateCatalogSources(opclient v1alpha1typed.OperatorsV1alpha1Interface) error {
 	const (
 		timeout  = 5 * time.Minute
 		interval = 10 * time.Second
@@ -34,40 +35,52 @@ func validateCatalogSources(opclient v1alpha1typed.OperatorsV1alpha1Interface) e
 
 	return wait.PollUntilContextTimeout(context.TODO(), interval, timeout, true,
 		func(ctx context.Context) (bool, error) {
-			catalogSources, err := opclient.CatalogSources(
-				CatalogSourceNamespace).List(ctx, metav1.ListOptions{})
+			notReady, err := notReadyCatalogSource(ctx, opclient, requiredCatalogSources)
 			if err != nil {
 				return false, err
 			}
 
-			for _, name := range requiredCatalogSources {
-				idx := slices.IndexFunc(catalogSources.Items, func(cs v1alpha1.CatalogSource) bool {
-					return cs.Name == name
-				})
+			if notReady != "" {
+				klog.Infof("%s, waiting...", notReady)
 
-				if idx == -1 {
-					klog.Infof("Catalog source %s not found yet, waiting...", name)
+				return false, nil
+			}
 
-					return false, nil
-				}
+			klog.Infof("Catalog sources %v are READY", requiredCatalogSources)
 
-				cs := catalogSources.Items[idx]
-				if cs.Status.GRPCConnectionState == nil || cs.Status.GRPCConnectionState.LastObservedState != "READY" {
-					state := "nil"
-					if cs.Status.GRPCConnectionState != nil {
-						state = cs.Status.GRPCConnectionState.LastObservedState
-					}
+			return true, nil
+		})
+}
 
-					klog.Infof("Catalog source %s exists but is not READY (state: %s), waiting...", name, state)
+// notReadyCatalogSource returns why the first of the catalog sources that is missing or not READY
+// is not usable, empty when all of them are READY.
+func notReadyCatalogSource(ctx context.Context, opclient v1alpha1typed.OperatorsV1alpha1Interface, names []string) (string, error) {
+	catalogSources, err := opclient.CatalogSources(CatalogSourceNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", err
+	}
 
-					return false, nil
-				}
+	for _, name := range names {
+		idx := slices.IndexFunc(catalogSources.Items, func(cs v1alpha1.CatalogSource) bool {
+			return cs.Name == name
+		})
+
+		if idx == -1 {
+			return fmt.Sprintf("Catalog source %s not found", name), nil
+		}
 
-				klog.Infof("Catalog source %s is READY", name)
+		cs := catalogSources.Items[idx]
+		if cs.Status.GRPCConnectionState == nil || cs.Status.GRPCConnectionState.LastObservedState != "READY" {
+			state := "nil"
+			if cs.Status.GRPCConnectionState != nil {
+				state = cs.Status.GRPCConnectionState.LastObservedState
 			}
 
-			return true, nil
-		})
+			return fmt.Sprintf("Catalog source %s exists but is not READY (state: %s)", name, state), nil
+		}
+	}
+
+	return "", nil
 }
 
 func deleteCatalogSourceByName(name string) error {
@@ -0,0 +1,265 @@
+package globalhelper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	klog "k8s.io/klog/v2"
+)
+
+const (
+	certsuiteBuildTarget  = "build-certsuite-tool"
+	certsuiteBuildTimeout = 15 * time.Minute
+	certsuiteCacheDirName = "certsuite-qe"
+	dirtyHashLength       = 12
+)
+
+// CertsuiteBuildInfo identifies the certsuite build used by the binary launcher.
+type CertsuiteBuildInfo struct {
+	// Commit is the certsuite repo HEAD commit.
+	Commit string
+	// Version is the output of git describe, e.g. v5.4.0-12-g1234567-dirty.
+	Version string
+	// DirtyHash identifies the uncommitted changes of the repo, empty when the repo is clean.
+	DirtyHash string
+	// BinaryPath is the path of the cached certsuite binary.
+	BinaryPath string
+}
+
+// Dirty returns true when the binary was built with uncommitted changes.
+func (i *CertsuiteBuildInfo) Dirty() bool {
+	return i.DirtyHash != ""
+}
+
+func (i *CertsuiteBuildInfo) cacheKey() string {
+	if i.Dirty() {
+		return i.Commit + "-dirty-" + i.DirtyHash
+	}
+
+	return i.Commit
+}
+
+var (
+	certsuiteBinaryLock sync.RWMutex
+	certsuiteBinaryPath string
+	certsuiteBuildInfo  *CertsuiteBuildInfo
+)
+
+// BuildCertsuiteBinary builds certsuite from CERTSUITE_REPO_PATH when the binary launcher is
+// selected, and returns the path of the binary. Binaries are cached by commit and uncommitted
+// changes, so a repo that did not change is built only once. It is meant to be called from the
+// first function of a suite SynchronizedBeforeSuite, the returned path being handed to
+// SetCertsuiteBinary in the second one.
+func BuildCertsuiteBinary() (string, error) {
+	if GetConfiguration().LauncherName() != globalparameters.BinaryLauncherName {
+		return "", nil
+	}
+
+	buildInfo, err := buildCertsuiteBinary(GetConfiguration().General.CertsuiteRepoPath,
+		GetConfiguration().General.CertsuiteEntryPointBinary, certsuiteBinaryCacheDir())
+	if err != nil {
+		return "", err
+	}
+
+	certsuiteBinaryLock.Lock()
+	certsuiteBuildInfo = buildInfo
+	certsuiteBinaryLock.Unlock()
+
+	SetCertsuiteBinary(buildInfo.BinaryPath)
+
+	return buildInfo.BinaryPath, nil
+}
+
+// SetCertsuiteBinary makes the binary launcher run the given certsuite binary. An empty path
+// restores the binary of the certsuite repo path.
+func SetCertsuiteBinary(binaryPath string) {
+	certsuiteBinaryLock.Lock()
+	defer certsuiteBinaryLock.Unlock()
+
+	certsuiteBinaryPath = binaryPath
+}
+
+// GetCertsuiteBuildInfo returns the certsuite build made by BuildCertsuiteBinary, nil if none.
+func GetCertsuiteBuildInfo() *CertsuiteBuildInfo {
+	certsuiteBinaryLock.RLock()
+	defer certsuiteBinaryLock.RUnlock()
+
+	return certsuiteBuildInfo
+}
+
+func getCertsuiteBinary() string {
+	certsuiteBinaryLock.RLock()
+	defer certsuiteBinaryLock.RUnlock()
+
+	return certsuiteBinaryPath
+}
+
+func certsuiteBinaryCacheDir() string {
+	if GetConfiguration().General.CertsuiteBinaryCacheDir != "" {
+		return GetConfiguration().General.CertsuiteBinaryCacheDir
+	}
+
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		cacheDir = os.TempDir()
+	}
+
+	return filepath.Join(cacheDir, certsuiteCacheDirName)
+}
+
+func buildCertsuiteBinary(repoPath, entryPointBinary, cacheDir string) (*CertsuiteBuildInfo, error) {
+	if repoPath == "" {
+		return nil, fmt.Errorf("CERTSUITE_REPO_PATH env variable is not set. Please export CERTSUITE_REPO_PATH")
+	}
+
+	buildInfo, err := readCertsuiteBuildInfo(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	buildInfo.BinaryPath = filepath.Join(cacheDir, "certsuite-"+buildInfo.cacheKey())
+
+	_, err = os.Stat(buildInfo.BinaryPath)
+	if err == nil {
+		klog.V(5).Infof("Using cached certsuite binary %s", buildInfo.BinaryPath)
+
+		return buildInfo, nil
+	}
+
+	// Without the go toolchain, the binary already built in the repo is run as is. It is not cached,
+	// it may have been built from another commit.
+	if _, err = exec.LookPath("go"); err != nil {
+		prebuiltBinary := filepath.Join(repoPath, entryPointBinary)
+		if _, statErr := os.Stat(prebuiltBinary); statErr != nil {
+			return nil, fmt.Errorf("failed to build certsuite in %s: go is not in PATH and no certsuite binary is built in it",
+				repoPath)
+		}
+
+		klog.Warningf("go is not in PATH, running the certsuite binary built in %s, it may not be %s", repoPath,
+			buildInfo.Version)
+
+		buildInfo.BinaryPath = prebuiltBinary
+
+		return buildInfo, nil
+	}
+
+	klog.V(5).Infof("Building certsuite %s from %s", buildInfo.Version, repoPath)
+
+	output, err := runInDir(repoPath, certsuiteBuildTimeout, "make", certsuiteBuildTarget)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build certsuite in %s: %w, output: %s", repoPath, err, output)
+	}
+
+	err = os.MkdirAll(cacheDir, globalparameters.DirPermissions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certsuite binary cache directory %s: %w", cacheDir, err)
+	}
+
+	err = copyExecutable(filepath.Join(repoPath, entryPointBinary), buildInfo.BinaryPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cache certsuite binary: %w", err)
+	}
+
+	return buildInfo, nil
+}
+
+func readCertsuiteBuildInfo(repoPath string) (*CertsuiteBuildInfo, error) {
+	commit, err := runInDir(repoPath, time.Minute, "git", "rev-parse", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certsuite repo commit: %w, output: %s", err, commit)
+	}
+
+	version, err := runInDir(repoPath, time.Minute, "git", "describe", "--tags", "--always", "--dirty")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certsuite repo version: %w, output: %s", err, version)
+	}
+
+	dirtyHash, err := repoDirtyHash(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CertsuiteBuildInfo{Commit: commit, Version: version, DirtyHash: dirtyHash}, nil
+}
+
+// repoDirtyHash hashes the uncommitted changes of a repo, tracked and untracked files included.
+// It returns an empty string for a clean repo.
+func repoDirtyHash(repoPath string) (string, error) {
+	status, err := runInDir(repoPath, time.Minute, "git", "status", "--porcelain")
+	if err != nil {
+		return "", fmt.Errorf("failed to get certsuite repo status: %w, output: %s", err, status)
+	}
+
+	if status == "" {
+		return "", nil
+	}
+
+	diff, err := runInDir(repoPath, time.Minute, "git", "diff", "HEAD", "--binary")
+	if err != nil {
+		return "", fmt.Errorf("failed to get certsuite repo diff: %w", err)
+	}
+
+	untracked, err := runInDir(repoPath, time.Minute, "git", "ls-files", "--others", "--exclude-standard")
+	if err != nil {
+		return "", fmt.Errorf("failed to list certsuite repo untracked files: %w", err)
+	}
+
+	hash := sha256.New()
+	_, _ = io.WriteString(hash, diff)
+
+	for _, fileName := range strings.Split(untracked, "\n") {
+		if fileName == "" {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(repoPath, fileName))
+		if err != nil {
+			return "", fmt.Errorf("failed to read certsuite repo untracked file %s: %w", fileName, err)
+		}
+
+		_, _ = io.WriteString(hash, fileName)
+		_, _ = hash.Write(content)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:dirtyHashLength], nil
+}
+
+func runInDir(dir string, timeout time.Duration, name string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+
+	output, err := cmd.CombinedOutput()
+
+	return strings.TrimSpace(string(output)), err
+}
+
+// copyExecutable copies a binary through a temporary file so that a concurrent reader never sees
+// a partially written binary.
+func copyExecutable(src, dst string) error {
+	content, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	tmpFile := fmt.Sprintf("%s.%d.tmp", dst, os.Getpid())
+
+	err = os.WriteFile(tmpFile, content, 0755)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile, dst)
+}
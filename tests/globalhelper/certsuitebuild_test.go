@@ -0,0 +1,161 @@
+package globalhelper
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// The build target appends a line to builds.log each time it runs.
+const testCertsuiteMakefile = `build-certsuite-tool:
+	printf '#!/bin/sh\n' > certsuite
+	chmod +x certsuite
+	echo build >> builds.log
+`
+
+func createTestCertsuiteRepo(t *testing.T) string {
+	t.Helper()
+
+	repoPath := t.TempDir()
+
+	assert.Nil(t, os.WriteFile(filepath.Join(repoPath, "Makefile"), []byte(testCertsuiteMakefile), 0600))
+	assert.Nil(t, os.WriteFile(filepath.Join(repoPath, "main.go"), []byte("package main\n"), 0600))
+	assert.Nil(t, os.WriteFile(filepath.Join(repoPath, ".gitignore"), []byte("certsuite\nbuilds.log\n"), 0600))
+
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "-A"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "initial"},
+	} {
+		output, err := runInDir(repoPath, certsuiteBuildTimeout, "git", args...)
+		assert.Nil(t, err, output)
+	}
+
+	return repoPath
+}
+
+func countTestCertsuiteBuilds(t *testing.T, repoPath string) int {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join(repoPath, "builds.log"))
+	if os.IsNotExist(err) {
+		return 0
+	}
+
+	assert.Nil(t, err)
+
+	return strings.Count(string(content), "build\n")
+}
+
+func TestBuildCertsuiteBinaryCache(t *testing.T) {
+	repoPath := createTestCertsuiteRepo(t)
+	cacheDir := t.TempDir()
+
+	buildInfo, err := buildCertsuiteBinary(repoPath, "certsuite", cacheDir)
+	assert.Nil(t, err)
+	assert.False(t, buildInfo.Dirty())
+	assert.NotEmpty(t, buildInfo.Commit)
+	assert.Equal(t, filepath.Join(cacheDir, "certsuite-"+buildInfo.Commit), buildInfo.BinaryPath)
+	assert.FileExists(t, buildInfo.BinaryPath)
+	assert.Equal(t, 1, countTestCertsuiteBuilds(t, repoPath))
+
+	cachedBuildInfo, err := buildCertsuiteBinary(repoPath, "certsuite", cacheDir)
+	assert.Nil(t, err)
+	assert.Equal(t, buildInfo.BinaryPath, cachedBuildInfo.BinaryPath)
+	assert.Equal(t, 1, countTestCertsuiteBuilds(t, repoPath))
+
+	assert.Nil(t, os.WriteFile(filepath.Join(repoPath, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0600))
+
+	dirtyBuildInfo, err := buildCertsuiteBinary(repoPath, "certsuite", cacheDir)
+	assert.Nil(t, err)
+	assert.True(t, dirtyBuildInfo.Dirty())
+	assert.Equal(t, buildInfo.Commit, dirtyBuildInfo.Commit)
+	assert.True(t, strings.HasSuffix(dirtyBuildInfo.Version, "-dirty"))
+	assert.NotEqual(t, buildInfo.BinaryPath, dirtyBuildInfo.BinaryPath)
+	assert.Equal(t, 2, countTestCertsuiteBuilds(t, repoPath))
+
+	assert.Nil(t, os.WriteFile(filepath.Join(repoPath, "version.go"), []byte("package main\n"), 0600))
+
+	otherDirtyBuildInfo, err := buildCertsuiteBinary(repoPath, "certsuite", cacheDir)
+	assert.Nil(t, err)
+	assert.NotEqual(t, dirtyBuildInfo.DirtyHash, otherDirtyBuildInfo.DirtyHash)
+	assert.Equal(t, 3, countTestCertsuiteBuilds(t, repoPath))
+}
+
+func TestBuildCertsuiteBinaryWithoutGo(t *testing.T) {
+	repoPath := createTestCertsuiteRepo(t)
+	cacheDir := t.TempDir()
+
+	// A PATH with the tools the build needs, but not go.
+	binDir := t.TempDir()
+
+	for _, tool := range []string{"git", "make", "chmod"} {
+		toolPath, err := exec.LookPath(tool)
+		assert.Nil(t, err)
+		assert.Nil(t, os.Symlink(toolPath, filepath.Join(binDir, tool)))
+	}
+
+	t.Setenv("PATH", binDir)
+
+	_, err := buildCertsuiteBinary(repoPath, "certsuite", cacheDir)
+	assert.ErrorContains(t, err, "go is not in PATH and no certsuite binary is built in it")
+
+	assert.Nil(t, os.WriteFile(filepath.Join(repoPath, "certsuite"), []byte("#!/bin/sh\n"), 0700))
+
+	buildInfo, err := buildCertsuiteBinary(repoPath, "certsuite", cacheDir)
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(repoPath, "certsuite"), buildInfo.BinaryPath)
+	assert.Equal(t, 0, countTestCertsuiteBuilds(t, repoPath))
+}
+
+func TestBuildCertsuiteBinaryErrors(t *testing.T) {
+	_, err := buildCertsuiteBinary("", "certsuite", t.TempDir())
+	assert.NotNil(t, err)
+
+	_, err = buildCertsuiteBinary(t.TempDir(), "certsuite", t.TempDir())
+	assert.NotNil(t, err)
+}
+
+func TestBuildCertsuiteBinary(t *testing.T) {
+	originalConf := conf
+
+	defer func() {
+		conf = originalConf
+		certsuiteBuildInfo = nil
+
+		SetCertsuiteBinary("")
+	}()
+
+	conf = &config.Config{}
+	conf.General.Launcher = globalparameters.ContainerLauncherName
+
+	binaryPath, err := BuildCertsuiteBinary()
+	assert.Nil(t, err)
+	assert.Empty(t, binaryPath)
+	assert.Nil(t, GetCertsuiteBuildInfo())
+
+	conf.General.Launcher = globalparameters.BinaryLauncherName
+	conf.General.CertsuiteRepoPath = createTestCertsuiteRepo(t)
+	conf.General.CertsuiteEntryPointBinary = "certsuite"
+	conf.General.CertsuiteBinaryCacheDir = t.TempDir()
+
+	binaryPath, err = BuildCertsuiteBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, binaryPath, GetCertsuiteBuildInfo().BinaryPath)
+
+	request := LaunchRequest{}
+	requestBinaryPath, err := request.binaryPath()
+	assert.Nil(t, err)
+	assert.Equal(t, binaryPath, requestBinaryPath)
+
+	properties := certsuiteJUnitProperties()
+	assert.Equal(t, GetCertsuiteBuildInfo().Commit, properties["certsuite.commit"])
+	assert.Equal(t, "false", properties["certsuite.dirty"])
+	assert.Equal(t, binaryPath, properties["certsuite.binary"])
+}
@@ -0,0 +1,224 @@
+package globalhelper
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"gopkg.in/yaml.v3"
+	klog "k8s.io/klog/v2"
+)
+
+// CertsuiteConfigBuilder builds a certsuite_config.yml. The With methods can be chained, the
+// first invalid value is returned by Build or Write.
+//
+//	err := globalhelper.NewCertsuiteConfig(randomNamespace).
+//		WithPodLabels(tsparams.TestPodLabel).
+//		WithCrdFilter(tsparams.CertsuiteTargetCrdFilters, true).
+//		Write(randomCertsuiteConfigDir)
+type CertsuiteConfigBuilder struct {
+	config globalparameters.CertsuiteConfig
+	err    error
+}
+
+// NewCertsuiteConfig returns a builder of a configuration targeting the given namespaces, with the
+// QE collector submission details.
+func NewCertsuiteConfig(namespaces ...string) *CertsuiteConfigBuilder {
+	builder := &CertsuiteConfigBuilder{}
+	builder.config.ExecutedBy = ExecutedBy
+	builder.config.CollectorAppPassword = AppPwd
+	builder.config.PartnerName = defaultPartnerName()
+
+	builder.setErr(defineCertsuiteNamespaces(&builder.config, namespaces),
+		"failed to create namespaces section in certsuite yaml config file")
+
+	return builder
+}
+
+// WithPodLabels adds labels, in the "prefix/name: value" form, selecting the pods under test.
+func (b *CertsuiteConfigBuilder) WithPodLabels(labels ...string) *CertsuiteConfigBuilder {
+	b.setErr(definePodUnderTestLabels(&b.config, labels),
+		"failed to create target pod labels section in certsuite yaml config file")
+
+	return b
+}
+
+// WithOperatorLabels adds labels selecting the operators under test.
+func (b *CertsuiteConfigBuilder) WithOperatorLabels(labels ...string) *CertsuiteConfigBuilder {
+	b.config.OperatorsUnderTestLabels = append(b.config.OperatorsUnderTestLabels, labels...)
+
+	return b
+}
+
+// WithCertifiedContainer adds a container image to the certified containers info.
+func (b *CertsuiteConfigBuilder) WithCertifiedContainer(
+	info globalparameters.CertifiedContainerRepoInfo) *CertsuiteConfigBuilder {
+	b.config.Certifiedcontainerinfo = append(b.config.Certifiedcontainerinfo, info)
+
+	return b
+}
+
+// WithCrdFilter adds the CRDs whose name ends with the suffix to the CRDs under test. certsuite
+// scales their custom resources only when scalable is true.
+func (b *CertsuiteConfigBuilder) WithCrdFilter(nameSuffix string, scalable bool) *CertsuiteConfigBuilder {
+	if nameSuffix == "" {
+		b.setErr(errors.New("crd filter name suffix cannot be empty"), "invalid crd filter")
+
+		return b
+	}
+
+	b.config.TargetCrdFilters = append(b.config.TargetCrdFilters, globalparameters.TargetCrdFilter{
+		NameSuffix: nameSuffix,
+		Scalable:   scalable,
+	})
+
+	return b
+}
+
+// WithManagedDeployments adds deployments managed by a custom resource.
+func (b *CertsuiteConfigBuilder) WithManagedDeployments(names ...string) *CertsuiteConfigBuilder {
+	for _, name := range names {
+		b.config.ManagedDeployments = append(b.config.ManagedDeployments, globalparameters.ManagedController{Name: name})
+	}
+
+	return b
+}
+
+// WithManagedStatefulSets adds statefulsets managed by a custom resource.
+func (b *CertsuiteConfigBuilder) WithManagedStatefulSets(names ...string) *CertsuiteConfigBuilder {
+	for _, name := range names {
+		b.config.ManagedStatefulsets = append(b.config.ManagedStatefulsets, globalparameters.ManagedController{Name: name})
+	}
+
+	return b
+}
+
+// WithSkipScalingTestDeployments adds deployments of a namespace certsuite does not scale.
+func (b *CertsuiteConfigBuilder) WithSkipScalingTestDeployments(namespace string,
+	names ...string) *CertsuiteConfigBuilder {
+	for _, name := range names {
+		b.config.SkipScalingTestDeployments = append(b.config.SkipScalingTestDeployments,
+			globalparameters.SkipScalingTestObject{Name: name, Namespace: namespace})
+	}
+
+	return b
+}
+
+// WithSkipScalingTestStatefulSets adds statefulsets of a namespace certsuite does not scale.
+func (b *CertsuiteConfigBuilder) WithSkipScalingTestStatefulSets(namespace string,
+	names ...string) *CertsuiteConfigBuilder {
+	for _, name := range names {
+		b.config.SkipScalingTestStatefulSets = append(b.config.SkipScalingTestStatefulSets,
+			globalparameters.SkipScalingTestObject{Name: name, Namespace: namespace})
+	}
+
+	return b
+}
+
+// WithAcceptedKernelTaints adds kernel modules whose taints certsuite accepts.
+func (b *CertsuiteConfigBuilder) WithAcceptedKernelTaints(modules ...string) *CertsuiteConfigBuilder {
+	for _, module := range modules {
+		b.config.AcceptedKernelTaints = append(b.config.AcceptedKernelTaints,
+			globalparameters.AcceptedKernelTaint{Module: module})
+	}
+
+	return b
+}
+
+// WithSkipHelmCharts adds helm charts certsuite does not check.
+func (b *CertsuiteConfigBuilder) WithSkipHelmCharts(names ...string) *CertsuiteConfigBuilder {
+	for _, name := range names {
+		b.config.SkipHelmChartList = append(b.config.SkipHelmChartList, globalparameters.SkipHelmChart{Name: name})
+	}
+
+	return b
+}
+
+// WithValidProtocolNames adds protocol names accepted in service and container port names.
+func (b *CertsuiteConfigBuilder) WithValidProtocolNames(names ...string) *CertsuiteConfigBuilder {
+	b.config.ValidProtocolNames = append(b.config.ValidProtocolNames, names...)
+
+	return b
+}
+
+// WithServicesIgnoreList adds services certsuite does not check.
+func (b *CertsuiteConfigBuilder) WithServicesIgnoreList(names ...string) *CertsuiteConfigBuilder {
+	b.config.ServicesIgnoreList = append(b.config.ServicesIgnoreList, names...)
+
+	return b
+}
+
+// WithProbeDaemonSetNamespace sets the namespace certsuite deploys its probe pods in.
+func (b *CertsuiteConfigBuilder) WithProbeDaemonSetNamespace(namespace string) *CertsuiteConfigBuilder {
+	b.config.ProbeDaemonSetNamespace = namespace
+
+	return b
+}
+
+// WithExecutedBy overrides the collector submission details.
+func (b *CertsuiteConfigBuilder) WithExecutedBy(executedBy, partnerName, collectorAppPassword string) *CertsuiteConfigBuilder {
+	b.config.ExecutedBy = executedBy
+	b.config.PartnerName = partnerName
+	b.config.CollectorAppPassword = collectorAppPassword
+
+	return b
+}
+
+// Build returns the configuration, or the first invalid value given to the builder.
+func (b *CertsuiteConfigBuilder) Build() (globalparameters.CertsuiteConfig, error) {
+	if b.err != nil {
+		return globalparameters.CertsuiteConfig{}, b.err
+	}
+
+	return b.config, nil
+}
+
+// Write creates certsuite_config.yml file under certsuite config directory.
+func (b *CertsuiteConfigBuilder) Write(configDir string) error {
+	certsuiteConfig, err := b.Build()
+	if err != nil {
+		return err
+	}
+
+	return writeCertsuiteConfig(certsuiteConfig, configDir)
+}
+
+func (b *CertsuiteConfigBuilder) setErr(err error, message string) {
+	if err != nil && b.err == nil {
+		b.err = fmt.Errorf("%s: %w", message, err)
+	}
+}
+
+// defaultPartnerName returns the QE partner name, suffixed with the CI job ID when there is one,
+// e.g. "qeuser_1234".
+func defaultPartnerName() string {
+	jobID := os.Getenv("JOB_ID")
+	if jobID != "" {
+		return fmt.Sprintf("%s_%s", PartnerName, jobID)
+	}
+
+	return PartnerName
+}
+
+func writeCertsuiteConfig(certsuiteConfig globalparameters.CertsuiteConfig, configDir string) error {
+	certsuiteConfigFilePath := path.Join(configDir, globalparameters.DefaultCertsuiteConfigFileName)
+
+	configFile, err := os.OpenFile(certsuiteConfigFilePath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("error opening/creating file %s: %w", certsuiteConfigFilePath, err)
+	}
+
+	defer configFile.Close()
+
+	err = yaml.NewEncoder(configFile).Encode(certsuiteConfig)
+	if err != nil {
+		return fmt.Errorf("failed to encode certsuite yaml config file on %s: %w", certsuiteConfigFilePath, err)
+	}
+
+	klog.V(5).Infof("%s deployed under %s directory",
+		globalparameters.DefaultCertsuiteConfigFileName, configDir)
+
+	return nil
+}
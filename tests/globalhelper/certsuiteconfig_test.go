@@ -0,0 +1,101 @@
+package globalhelper
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestCertsuiteConfigBuilder(t *testing.T) {
+	t.Setenv("JOB_ID", "")
+
+	configDir := t.TempDir()
+
+	err := NewCertsuiteConfig("ns1", "ns2").
+		WithPodLabels("test-network-function.com/generic: target").
+		WithOperatorLabels("test-network-function.com/operator: target").
+		WithCrdFilter("example.com", true).
+		WithManagedDeployments("managed-deployment").
+		WithManagedStatefulSets("managed-statefulset").
+		WithSkipScalingTestDeployments("ns1", "deployment1", "deployment2").
+		WithSkipScalingTestStatefulSets("ns2", "statefulset1").
+		WithAcceptedKernelTaints("taint-module").
+		WithSkipHelmCharts("chart").
+		WithValidProtocolNames("http3").
+		WithServicesIgnoreList("service").
+		WithProbeDaemonSetNamespace("probe-ns").
+		Write(configDir)
+	assert.Nil(t, err)
+
+	content, err := os.ReadFile(path.Join(configDir, globalparameters.DefaultCertsuiteConfigFileName))
+	assert.Nil(t, err)
+
+	var certsuiteConfig globalparameters.CertsuiteConfig
+
+	assert.Nil(t, yaml.Unmarshal(content, &certsuiteConfig))
+	assert.Equal(t, globalparameters.CertsuiteConfig{
+		TargetNameSpaces:         []globalparameters.TargetNameSpace{{Name: "ns1"}, {Name: "ns2"}},
+		PodsUnderTestLabels:      []string{"test-network-function.com/generic: target"},
+		OperatorsUnderTestLabels: []string{"test-network-function.com/operator: target"},
+		Certifiedcontainerinfo:   []globalparameters.CertifiedContainerRepoInfo{},
+		TargetCrdFilters:         []globalparameters.TargetCrdFilter{{NameSuffix: "example.com", Scalable: true}},
+		ManagedDeployments:       []globalparameters.ManagedController{{Name: "managed-deployment"}},
+		ManagedStatefulsets:      []globalparameters.ManagedController{{Name: "managed-statefulset"}},
+		AcceptedKernelTaints:     []globalparameters.AcceptedKernelTaint{{Module: "taint-module"}},
+		SkipHelmChartList:        []globalparameters.SkipHelmChart{{Name: "chart"}},
+		SkipScalingTestDeployments: []globalparameters.SkipScalingTestObject{
+			{Name: "deployment1", Namespace: "ns1"}, {Name: "deployment2", Namespace: "ns1"}},
+		SkipScalingTestStatefulSets: []globalparameters.SkipScalingTestObject{{Name: "statefulset1", Namespace: "ns2"}},
+		ValidProtocolNames:          []string{"http3"},
+		ServicesIgnoreList:          []string{"service"},
+		ProbeDaemonSetNamespace:     "probe-ns",
+		ExecutedBy:                  ExecutedBy,
+		CollectorAppPassword:        AppPwd,
+		PartnerName:                 PartnerName,
+	}, certsuiteConfig)
+
+	assert.Contains(t, string(content), "skipScalingTestStatefulsets:")
+	assert.Contains(t, string(content), "scalable: true")
+}
+
+func TestCertsuiteConfigBuilderOmitsUnsetFields(t *testing.T) {
+	certsuiteConfig, err := NewCertsuiteConfig("ns1").WithCrdFilter("example.com", false).Build()
+	assert.Nil(t, err)
+
+	content, err := yaml.Marshal(certsuiteConfig)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(content), "scalable")
+	assert.NotContains(t, string(content), "managedDeployments")
+	assert.NotContains(t, string(content), "probeDaemonSetNamespace")
+}
+
+func TestCertsuiteConfigBuilderErrors(t *testing.T) {
+	testCases := []struct {
+		builder       *CertsuiteConfigBuilder
+		expectedError string
+	}{
+		{NewCertsuiteConfig(), "target namespaces cannot be empty list"},
+		{NewCertsuiteConfig("ns1").WithPodLabels("invalid"), "podUnderTest label invalid is invalid"},
+		{NewCertsuiteConfig("ns1").WithCrdFilter("", true), "crd filter name suffix cannot be empty"},
+		// The first error is kept.
+		{NewCertsuiteConfig().WithPodLabels("invalid"), "target namespaces cannot be empty list"},
+	}
+
+	for _, testCase := range testCases {
+		_, err := testCase.builder.Build()
+		assert.ErrorContains(t, err, testCase.expectedError)
+		assert.ErrorContains(t, testCase.builder.Write(t.TempDir()), testCase.expectedError)
+	}
+}
+
+func TestDefaultPartnerName(t *testing.T) {
+	t.Setenv("JOB_ID", "1234")
+	assert.Equal(t, PartnerName+"_1234", defaultPartnerName())
+
+	t.Setenv("JOB_ID", "")
+	assert.Equal(t, PartnerName, defaultPartnerName())
+}
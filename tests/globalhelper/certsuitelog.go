@@ -0,0 +1,250 @@
+package globalhelper
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	klog "k8s.io/klog/v2"
+)
+
+// CertsuiteLogFileName is the name of the log certsuite writes in its output directory.
+const CertsuiteLogFileName = "certsuite.log"
+
+// maxLogLineSize is the longest certsuite log line analyzed, longer lines are truncated.
+const maxLogLineSize = 1024 * 1024
+
+// LogFindingKind tells what was found in a certsuite log.
+type LogFindingKind string
+
+const (
+	// LogFindingPanic is a go panic or fatal error: certsuite crashed.
+	LogFindingPanic LogFindingKind = "panic"
+	// LogFindingGoroutineDump is a goroutine stack dump, printed on a crash or a deadlock.
+	LogFindingGoroutineDump LogFindingKind = "goroutine-dump"
+	// LogFindingProbe is a message about a missing or unusable probe pod.
+	LogFindingProbe LogFindingKind = "probe"
+	// LogFindingError is a line logged with the ERROR or FATAL level.
+	LogFindingError LogFindingKind = "error"
+)
+
+// Log patterns checked in order, the first matching one gives the kind of a line.
+var certsuiteLogPatterns = []struct {
+	kind  LogFindingKind
+	regex *regexp.Regexp
+}{
+	{LogFindingPanic, regexp.MustCompile(`^(panic: |fatal error: )`)},
+	{LogFindingGoroutineDump, regexp.MustCompile(`^goroutine \d+ \[[^\]]+\]:$`)},
+	{LogFindingProbe, regexp.MustCompile(`(?i)probe ?pods? .*(not found|not ready|not running|missing)|` +
+		`(no|could not find|cannot find|failed to find) (the )?probe ?pod`)},
+	{LogFindingError, logLevelRegex},
+}
+
+// LogFinding is a notable line of a certsuite log.
+type LogFinding struct {
+	Kind LogFindingKind
+	// Line is the 1-based line number in the log.
+	Line int
+	Text string
+}
+
+func (f LogFinding) String() string {
+	return fmt.Sprintf("%s at line %d: %s", f.Kind, f.Line, f.Text)
+}
+
+// CertsuiteLogAnalysis holds the notable lines of a certsuite log, at most maxLogEntries of each
+// kind.
+type CertsuiteLogAnalysis struct {
+	Findings []LogFinding
+}
+
+// Of returns the findings of a kind.
+func (a *CertsuiteLogAnalysis) Of(kind LogFindingKind) []LogFinding {
+	var findings []LogFinding
+
+	for _, finding := range a.Findings {
+		if finding.Kind == kind {
+			findings = append(findings, finding)
+		}
+	}
+
+	return findings
+}
+
+// Err returns an error when certsuite crashed: the log has a panic or a goroutine dump. Errors and
+// probe messages are reported without failing the spec since certsuite logs some of them on
+// healthy runs.
+func (a *CertsuiteLogAnalysis) Err() error {
+	crashes := append(a.Of(LogFindingPanic), a.Of(LogFindingGoroutineDump)...)
+	if len(crashes) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("certsuite log shows a crash: %s", crashes[0])
+}
+
+// AnalyzeCertsuiteLog reads a certsuite log and returns its panics, goroutine dumps, probe pod
+// messages and error lines.
+func AnalyzeCertsuiteLog(reader io.Reader) (*CertsuiteLogAnalysis, error) {
+	analysis := &CertsuiteLogAnalysis{}
+	counts := map[LogFindingKind]int{}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineSize)
+
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+
+		line := strings.TrimRight(ansiEscapeRegex.ReplaceAllString(scanner.Text(), ""), " \t\r")
+
+		kind, found := classifyLogLine(line)
+		if !found || counts[kind] >= maxLogEntries {
+			continue
+		}
+
+		counts[kind]++
+		analysis.Findings = append(analysis.Findings, LogFinding{Kind: kind, Line: lineNumber, Text: line})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return analysis, fmt.Errorf("failed to read certsuite log: %w", err)
+	}
+
+	return analysis, nil
+}
+
+func classifyLogLine(line string) (LogFindingKind, bool) {
+	for _, pattern := range certsuiteLogPatterns {
+		match := pattern.regex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		// The level regex also matches the other levels.
+		if pattern.kind == LogFindingError && match[1] != "ERROR" && match[1] != "FATAL" {
+			return "", false
+		}
+
+		return pattern.kind, true
+	}
+
+	return "", false
+}
+
+// AnalyzeCertsuiteLogFile analyzes the certsuite log of a report directory, or the certsuite job
+// output when certsuite ran in the cluster. The analysis is empty when there is no log.
+func AnalyzeCertsuiteLogFile(reportDir string) (*CertsuiteLogAnalysis, error) {
+	logPath := findCertsuiteLog(reportDir)
+	if logPath == "" {
+		return &CertsuiteLogAnalysis{}, nil
+	}
+
+	logFile, err := os.Open(logPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open certsuite log: %w", err)
+	}
+
+	defer logFile.Close()
+
+	return AnalyzeCertsuiteLog(logFile)
+}
+
+func findCertsuiteLog(reportDir string) string {
+	for _, name := range []string{CertsuiteLogFileName, jobLogFileName} {
+		logPath := path.Join(reportDir, name)
+		if _, err := os.Stat(logPath); err == nil {
+			return logPath
+		}
+	}
+
+	return ""
+}
+
+// checkCertsuiteLog analyzes the certsuite log of a run, adds its findings to the launch result
+// and the spec report, and returns an error when certsuite crashed.
+func checkCertsuiteLog(reportDir string, result *LaunchResult) error {
+	analysis, err := AnalyzeCertsuiteLogFile(reportDir)
+	if err != nil {
+		klog.ErrorS(err, "failed to analyze certsuite log", "dir", reportDir)
+	}
+
+	if analysis == nil {
+		return nil
+	}
+
+	if result != nil && len(analysis.Findings) > 0 {
+		result.LogFindings = analysis.Findings
+	}
+
+	reportLogFindings(analysis)
+
+	return analysis.Err()
+}
+
+// reportLogFindings attaches the certsuite log findings to the current spec report. It does
+// nothing outside a running spec.
+func reportLogFindings(analysis *CertsuiteLogAnalysis) {
+	if len(analysis.Findings) == 0 || CurrentSpecReport().LeafNodeType == types.NodeTypeInvalid {
+		return
+	}
+
+	lines := make([]string, 0, len(analysis.Findings))
+	for _, finding := range analysis.Findings {
+		lines = append(lines, finding.String())
+	}
+
+	AddReportEntry("certsuite log findings", strings.Join(lines, "\n"))
+}
+
+// launchedDebugDirs maps the report directories of the certsuite runs to the debug folders of
+// their specs, for PreserveCertsuiteLog.
+var launchedDebugDirs sync.Map
+
+func rememberDebugDir(reportDir, tcName, formattedTcName string) {
+	debugDir, err := tcDebugDir(tcName, formattedTcName)
+	if err == nil {
+		launchedDebugDirs.Store(reportDir, debugDir)
+	}
+}
+
+// PreserveCertsuiteLog copies the certsuite log of the last run in a report directory to the debug
+// folder of its spec, next to the claim.
+func PreserveCertsuiteLog(reportDir string) error {
+	value, found := launchedDebugDirs.Load(reportDir)
+	if !found {
+		return nil
+	}
+
+	debugDir, isString := value.(string)
+	if !isString {
+		return errors.New("invalid debug folder")
+	}
+
+	logPath := findCertsuiteLog(reportDir)
+	if logPath == "" {
+		return nil
+	}
+
+	err := os.MkdirAll(debugDir, globalparameters.DirPermissions)
+	if err != nil {
+		return fmt.Errorf("failed to create debug folder %s: %w", debugDir, err)
+	}
+
+	err = CopyFiles(logPath, path.Join(debugDir, path.Base(logPath)))
+	if err != nil {
+		return fmt.Errorf("failed to copy certsuite log to %s: %w", debugDir, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package globalhelper
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCertsuiteLog = `INFO  [Oct 17 10:00:00.000] [certsuite.go: 84] Certsuite Version: v5
+WARN  [Oct 17 10:00:01.000] [autodiscover.go: 12] no operators found
+ERROR [Oct 17 10:00:02.000] [checksdb.go: 20] check failed
+ERROR [Oct 17 10:00:03.000] [probepods.go: 40] probe pod not found on node worker-0
+panic: runtime error: invalid memory address or nil pointer dereference
+
+goroutine 1 [running]:
+main.main()
+`
+
+func TestAnalyzeCertsuiteLog(t *testing.T) {
+	analysis, err := AnalyzeCertsuiteLog(strings.NewReader(testCertsuiteLog))
+	assert.Nil(t, err)
+
+	assert.Equal(t, []LogFinding{
+		{Kind: LogFindingError, Line: 3, Text: "ERROR [Oct 17 10:00:02.000] [checksdb.go: 20] check failed"},
+		{Kind: LogFindingProbe, Line: 4, Text: "ERROR [Oct 17 10:00:03.000] [probepods.go: 40] probe pod not found on node worker-0"},
+		{Kind: LogFindingPanic, Line: 5, Text: "panic: runtime error: invalid memory address or nil pointer dereference"},
+		{Kind: LogFindingGoroutineDump, Line: 7, Text: "goroutine 1 [running]:"},
+	}, analysis.Findings)
+	assert.Len(t, analysis.Of(LogFindingPanic), 1)
+	assert.ErrorContains(t, analysis.Err(), "panic at line 5")
+}
+
+func TestAnalyzeCertsuiteLogWithoutCrash(t *testing.T) {
+	analysis, err := AnalyzeCertsuiteLog(strings.NewReader("ERROR [Oct 17 10:00:02.000] check failed\n" +
+		"INFO  no probe pods were deployed\nINFO  done\n"))
+	assert.Nil(t, err)
+
+	assert.Len(t, analysis.Of(LogFindingError), 1)
+	assert.Len(t, analysis.Of(LogFindingProbe), 1)
+	assert.Nil(t, analysis.Err())
+}
+
+func TestAnalyzeCertsuiteLogLimit(t *testing.T) {
+	var logContent strings.Builder
+
+	for i := range maxLogEntries + 10 {
+		fmt.Fprintf(&logContent, "ERROR error %d\n", i)
+	}
+
+	analysis, err := AnalyzeCertsuiteLog(strings.NewReader(logContent.String()))
+	assert.Nil(t, err)
+	assert.Len(t, analysis.Findings, maxLogEntries)
+}
+
+func TestAnalyzeCertsuiteLogFile(t *testing.T) {
+	reportDir := t.TempDir()
+
+	analysis, err := AnalyzeCertsuiteLogFile(reportDir)
+	assert.Nil(t, err)
+	assert.Empty(t, analysis.Findings)
+
+	assert.Nil(t, os.WriteFile(path.Join(reportDir, jobLogFileName), []byte(testCertsuiteLog), 0600))
+
+	analysis, err = AnalyzeCertsuiteLogFile(reportDir)
+	assert.Nil(t, err)
+	assert.Len(t, analysis.Findings, 4)
+
+	result := &LaunchResult{}
+	assert.NotNil(t, checkCertsuiteLog(reportDir, result))
+	assert.Len(t, result.LogFindings, 4)
+}
+
+func TestPreserveCertsuiteLog(t *testing.T) {
+	originalConf := conf
+
+	defer func() { conf = originalConf }()
+
+	conf = &config.Config{}
+	conf.General.ReportDirAbsPath = t.TempDir()
+
+	reportDir := t.TempDir()
+
+	// Nothing was launched from this report directory.
+	assert.Nil(t, PreserveCertsuiteLog(reportDir))
+
+	rememberDebugDir(reportDir, "observability-container-logging", "spec_name")
+	assert.Nil(t, os.WriteFile(path.Join(reportDir, CertsuiteLogFileName), []byte(testCertsuiteLog), 0600))
+
+	assert.Nil(t, PreserveCertsuiteLog(reportDir))
+
+	content, err := os.ReadFile(path.Join(conf.General.ReportDirAbsPath, "Debug",
+		globalparameters.ObservabilitySuiteName, "spec_name", CertsuiteLogFileName))
+	assert.Nil(t, err)
+	assert.Equal(t, testCertsuiteLog, string(content))
+}
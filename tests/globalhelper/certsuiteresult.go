@@ -0,0 +1,146 @@
+package globalhelper
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	"github.com/redhat-best-practices-for-k8s/certsuite-claim/pkg/claim"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	klog "k8s.io/klog/v2"
+)
+
+const (
+	// CertsuiteResultFileName is the file of a spec debug folder recording the states it expected.
+	CertsuiteResultFileName = "certsuite-result.json"
+
+	certsuiteResultEntryName = "certsuite-result"
+)
+
+// CertsuiteResult records the state a spec expected for a certsuite test case, and the state
+// found in the claim.
+type CertsuiteResult struct {
+	Spec       string   `json:"spec"`
+	TestCase   string   `json:"testCase"`
+	Expected   []string `json:"expected"`
+	Actual     string   `json:"actual"`
+	SkipReason string   `json:"skipReason,omitempty"`
+	// CertsuiteVersion and ClusterVersion are read from the claim.
+	CertsuiteVersion string `json:"certsuiteVersion,omitempty"`
+	ClusterVersion   string `json:"clusterVersion,omitempty"`
+	// ImageTag is the certsuite image tag, empty with the binary launcher.
+	ImageTag string `json:"imageTag,omitempty"`
+}
+
+func (r CertsuiteResult) String() string {
+	return fmt.Sprintf("%s: expected %s, got %s", r.TestCase, strings.Join(r.Expected, " or "), r.Actual)
+}
+
+// recordCertsuiteResult adds the expected and actual states of a test case to the running spec
+// report, and to the spec debug folder for the run report.
+func recordCertsuiteResult(tcName string, expected []string, claimReport claim.Root) {
+	if CurrentSpecReport().LeafNodeType == types.NodeTypeInvalid {
+		return
+	}
+
+	result := newCertsuiteResult(CurrentSpecReport().FullText(), tcName, expected, claimReport)
+
+	AddReportEntry(certsuiteResultEntryName, result)
+
+	suiteName, err := getTestSuiteName(tcName)
+	if err != nil {
+		return
+	}
+
+	debugDir := path.Join(GetConfiguration().General.ReportDirAbsPath, "Debug", suiteName,
+		ConvertSpecNameToFileName(result.Spec))
+
+	err = writeCertsuiteResult(debugDir, result)
+	if err != nil {
+		klog.ErrorS(err, "failed to record certsuite result", "testCase", tcName, "dir", debugDir)
+	}
+}
+
+func newCertsuiteResult(spec, tcName string, expected []string, claimReport claim.Root) CertsuiteResult {
+	result := CertsuiteResult{Spec: spec, TestCase: tcName, Expected: expected}
+
+	if tcResult, err := getTestCaseResult(tcName, claimReport); err == nil {
+		result.Actual = tcResult.State
+		result.SkipReason = tcResult.SkipReason
+	}
+
+	if claimReport.Claim != nil && claimReport.Claim.Versions != nil {
+		result.CertsuiteVersion = claimReport.Claim.Versions.CertSuite
+		result.ClusterVersion = claimReport.Claim.Versions.Ocp
+
+		if result.ClusterVersion == "" {
+			result.ClusterVersion = claimReport.Claim.Versions.K8s
+		}
+	}
+
+	if GetConfiguration().LauncherName() != globalparameters.BinaryLauncherName {
+		result.ImageTag = GetConfiguration().General.CertsuiteImageTag
+	}
+
+	return result
+}
+
+// writeCertsuiteResult adds a result to the result file of a debug folder, replacing the
+// previous result of the same test case.
+func writeCertsuiteResult(debugDir string, result CertsuiteResult) error {
+	results, err := ReadCertsuiteResults(debugDir)
+	if err != nil {
+		return err
+	}
+
+	replaced := false
+
+	for index := range results {
+		if results[index].TestCase == result.TestCase {
+			results[index] = result
+			replaced = true
+		}
+	}
+
+	if !replaced {
+		results = append(results, result)
+	}
+
+	encoded, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal certsuite results: %w", err)
+	}
+
+	err = os.MkdirAll(debugDir, globalparameters.DirPermissions)
+	if err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", debugDir, err)
+	}
+
+	return os.WriteFile(path.Join(debugDir, CertsuiteResultFileName), encoded, 0600)
+}
+
+// ReadCertsuiteResults reads the results recorded in a spec debug folder, none if the folder
+// has no result file.
+func ReadCertsuiteResults(debugDir string) ([]CertsuiteResult, error) {
+	content, err := os.ReadFile(path.Join(debugDir, CertsuiteResultFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read certsuite results of %s: %w", debugDir, err)
+	}
+
+	var results []CertsuiteResult
+
+	err = json.Unmarshal(content, &results)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal certsuite results of %s: %w", debugDir, err)
+	}
+
+	return results, nil
+}
@@ -69,12 +69,12 @@ func LogCheckDetails(checkDetails *CheckDetails, checkDetailsErr error) {
 
 	for i, obj := range checkDetails.CompliantObjectsOut {
 		GinkgoWriter.Printf("Compliant[%d]: type=%s reason=%s\n",
-			i, obj.ObjectType, GetReportObjectFieldValue(obj, "Reason"))
+			i, obj.ObjectType, GetReportObjectReason(obj))
 	}
 
 	for i, obj := range checkDetails.NonCompliantObjectsOut {
 		GinkgoWriter.Printf("NonCompliant[%d]: type=%s reason=%s\n",
-			i, obj.ObjectType, GetReportObjectFieldValue(obj, "Reason"))
+			i, obj.ObjectType, GetReportObjectReason(obj))
 	}
 }
 
@@ -0,0 +1,283 @@
+package globalhelper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onsi/gomega/types"
+)
+
+// Report object field keys used by certsuite's testhelper.
+const (
+	ReportObjectNamespaceKey              = "Namespace"
+	ReportObjectNameKey                   = "Name"
+	ReportObjectReasonKey                 = "Reason"
+	ReportObjectReasonForNonComplianceKey = "Reason For Non Compliance"
+	ReportObjectReasonForComplianceKey    = "Reason For Compliance"
+)
+
+var reportObjectReasonKeys = []string{
+	ReportObjectReasonForNonComplianceKey,
+	ReportObjectReasonForComplianceKey,
+	ReportObjectReasonKey,
+}
+
+type reportObjectFilter struct {
+	description string
+	match       func(object *ReportObject) bool
+}
+
+// ReportObjectQuery selects report objects of a CheckDetails. Each filter method returns a new
+// query, so a query can be shared and refined, e.g.
+//
+//	details.NonCompliant().OfType("Container").InNamespace(ns).Named("test").WithReasonContaining("hostPID")
+type ReportObjectQuery struct {
+	kind    string
+	objects []*ReportObject
+	filters []reportObjectFilter
+}
+
+// Compliant returns a query over the compliant objects.
+func (d *CheckDetails) Compliant() *ReportObjectQuery {
+	if d == nil {
+		return &ReportObjectQuery{kind: "compliant"}
+	}
+
+	return &ReportObjectQuery{kind: "compliant", objects: d.CompliantObjectsOut}
+}
+
+// NonCompliant returns a query over the non-compliant objects.
+func (d *CheckDetails) NonCompliant() *ReportObjectQuery {
+	if d == nil {
+		return &ReportObjectQuery{kind: "non-compliant"}
+	}
+
+	return &ReportObjectQuery{kind: "non-compliant", objects: d.NonCompliantObjectsOut}
+}
+
+// Where returns a query keeping the objects accepted by match.
+func (q *ReportObjectQuery) Where(description string, match func(object *ReportObject) bool) *ReportObjectQuery {
+	filters := make([]reportObjectFilter, 0, len(q.filters)+1)
+	filters = append(filters, q.filters...)
+	filters = append(filters, reportObjectFilter{description: description, match: match})
+
+	return &ReportObjectQuery{kind: q.kind, objects: q.objects, filters: filters}
+}
+
+// OfType keeps the objects of the given type, e.g. Pod or Container.
+func (q *ReportObjectQuery) OfType(objectType string) *ReportObjectQuery {
+	return q.Where(fmt.Sprintf("of type %s", objectType), func(object *ReportObject) bool {
+		return object.ObjectType == objectType
+	})
+}
+
+// InNamespace keeps the objects of the given namespace.
+func (q *ReportObjectQuery) InNamespace(namespace string) *ReportObjectQuery {
+	return q.WithField(ReportObjectNamespaceKey, namespace)
+}
+
+// Named keeps the objects with the given name. The name is read from the "<ObjectType> Name"
+// field, e.g. "Pod Name", or from the "Name" field.
+func (q *ReportObjectQuery) Named(name string) *ReportObjectQuery {
+	return q.Where(fmt.Sprintf("named %s", name), func(object *ReportObject) bool {
+		return GetReportObjectName(object) == name
+	})
+}
+
+// WithNamePrefix keeps the objects whose name starts with prefix, e.g. the pods of a deployment.
+func (q *ReportObjectQuery) WithNamePrefix(prefix string) *ReportObjectQuery {
+	return q.Where(fmt.Sprintf("named %s*", prefix), func(object *ReportObject) bool {
+		return strings.HasPrefix(GetReportObjectName(object), prefix)
+	})
+}
+
+// WithField keeps the objects having the given field value.
+func (q *ReportObjectQuery) WithField(key, value string) *ReportObjectQuery {
+	return q.Where(fmt.Sprintf("with %s=%s", key, value), func(object *ReportObject) bool {
+		return hasReportObjectField(object, key, value)
+	})
+}
+
+// WithReasonContaining keeps the objects whose reason contains substr.
+func (q *ReportObjectQuery) WithReasonContaining(substr string) *ReportObjectQuery {
+	return q.Where(fmt.Sprintf("with reason containing %q", substr), func(object *ReportObject) bool {
+		return strings.Contains(GetReportObjectReason(object), substr)
+	})
+}
+
+// Objects returns the objects selected by the query.
+func (q *ReportObjectQuery) Objects() []*ReportObject {
+	var selected []*ReportObject
+
+	for _, object := range q.objects {
+		if q.matches(object) {
+			selected = append(selected, object)
+		}
+	}
+
+	return selected
+}
+
+// Unexpected returns the objects not selected by the query.
+func (q *ReportObjectQuery) Unexpected() []*ReportObject {
+	var unexpected []*ReportObject
+
+	for _, object := range q.objects {
+		if !q.matches(object) {
+			unexpected = append(unexpected, object)
+		}
+	}
+
+	return unexpected
+}
+
+// Count returns the number of objects selected by the query.
+func (q *ReportObjectQuery) Count() int {
+	return len(q.Objects())
+}
+
+// CountByType returns the number of objects selected by the query per object type.
+func (q *ReportObjectQuery) CountByType() map[string]int {
+	counts := map[string]int{}
+	for _, object := range q.Objects() {
+		counts[object.ObjectType]++
+	}
+
+	return counts
+}
+
+func (q *ReportObjectQuery) String() string {
+	descriptions := []string{q.kind + " objects"}
+	for _, filter := range q.filters {
+		descriptions = append(descriptions, filter.description)
+	}
+
+	return strings.Join(descriptions, " ")
+}
+
+func (q *ReportObjectQuery) matches(object *ReportObject) bool {
+	if object == nil {
+		return false
+	}
+
+	for _, filter := range q.filters {
+		if !filter.match(object) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetReportObjectName returns the name of a report object, read from the "<ObjectType> Name"
+// field or else from the "Name" field.
+func GetReportObjectName(obj *ReportObject) string {
+	if name := GetReportObjectFieldValue(obj, obj.ObjectType+" "+ReportObjectNameKey); name != "" {
+		return name
+	}
+
+	return GetReportObjectFieldValue(obj, ReportObjectNameKey)
+}
+
+// GetReportObjectReason returns the compliance or non-compliance reason of a report object.
+func GetReportObjectReason(obj *ReportObject) string {
+	for _, key := range reportObjectReasonKeys {
+		if reason := GetReportObjectFieldValue(obj, key); reason != "" {
+			return reason
+		}
+	}
+
+	return ""
+}
+
+func hasReportObjectField(obj *ReportObject, key, value string) bool {
+	for index, objectKey := range obj.ObjectFieldsKeys {
+		if objectKey == key && index < len(obj.ObjectFieldsValues) && obj.ObjectFieldsValues[index] == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func formatReportObjects(objects []*ReportObject) string {
+	if len(objects) == 0 {
+		return "    <none>"
+	}
+
+	lines := make([]string, 0, len(objects))
+	for _, key := range reportObjectKeys(objects) {
+		lines = append(lines, "    "+key)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// reportObjectQueryMatcher is a gomega matcher over a *ReportObjectQuery.
+type reportObjectQueryMatcher struct {
+	description string
+	match       func(query *ReportObjectQuery) bool
+	// objects returns the objects shown in the failure messages.
+	objects func(query *ReportObjectQuery) []*ReportObject
+}
+
+// HaveReportObjects succeeds when the query selects at least one object.
+//
+//nolint:ireturn
+func HaveReportObjects() types.GomegaMatcher {
+	return &reportObjectQueryMatcher{
+		description: "at least one object",
+		match:       func(query *ReportObjectQuery) bool { return query.Count() > 0 },
+		objects:     func(query *ReportObjectQuery) []*ReportObject { return query.objects },
+	}
+}
+
+// HaveReportObjectCount succeeds when the query selects exactly count objects.
+//
+//nolint:ireturn
+func HaveReportObjectCount(count int) types.GomegaMatcher {
+	return &reportObjectQueryMatcher{
+		description: fmt.Sprintf("exactly %d objects", count),
+		match:       func(query *ReportObjectQuery) bool { return query.Count() == count },
+		objects:     func(query *ReportObjectQuery) []*ReportObject { return query.objects },
+	}
+}
+
+// HaveNoUnexpectedReportObjects succeeds when the query selects every object, i.e. the claim has
+// no object the query does not expect.
+//
+//nolint:ireturn
+func HaveNoUnexpectedReportObjects() types.GomegaMatcher {
+	return &reportObjectQueryMatcher{
+		description: "no other object",
+		match:       func(query *ReportObjectQuery) bool { return len(query.Unexpected()) == 0 },
+		objects:     func(query *ReportObjectQuery) []*ReportObject { return query.Unexpected() },
+	}
+}
+
+func (m *reportObjectQueryMatcher) Match(actual interface{}) (bool, error) {
+	query, ok := actual.(*ReportObjectQuery)
+	if !ok {
+		return false, fmt.Errorf("report object matcher expects a *ReportObjectQuery, got %T", actual)
+	}
+
+	return m.match(query), nil
+}
+
+func (m *reportObjectQueryMatcher) FailureMessage(actual interface{}) string {
+	return m.message(actual, "to have")
+}
+
+func (m *reportObjectQueryMatcher) NegatedFailureMessage(actual interface{}) string {
+	return m.message(actual, "not to have")
+}
+
+func (m *reportObjectQueryMatcher) message(actual interface{}, verb string) string {
+	query, ok := actual.(*ReportObjectQuery)
+	if !ok {
+		return fmt.Sprintf("Expected a *ReportObjectQuery, got %T", actual)
+	}
+
+	return fmt.Sprintf("Expected %s %s %s, found %d:\n%s", query, verb, m.description, query.Count(),
+		formatReportObjects(m.objects(query)))
+}
@@ -0,0 +1,102 @@
+package globalhelper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestQueryCheckDetails() *CheckDetails {
+	return &CheckDetails{
+		CompliantObjectsOut: []*ReportObject{{
+			ObjectType:         "Pod",
+			ObjectFieldsKeys:   []string{ReportObjectReasonForComplianceKey, ReportObjectNamespaceKey, "Pod Name"},
+			ObjectFieldsValues: []string{"HostPid is not set", "ns1", "good-pod"},
+		}},
+		NonCompliantObjectsOut: []*ReportObject{
+			{
+				ObjectType:         "Pod",
+				ObjectFieldsKeys:   []string{ReportObjectReasonForNonComplianceKey, ReportObjectNamespaceKey, "Pod Name"},
+				ObjectFieldsValues: []string{"HostPid is set to true", "ns1", "bad-deployment-5f7c9-abcde"},
+			},
+			{
+				ObjectType: "Container",
+				ObjectFieldsKeys: []string{ReportObjectReasonForNonComplianceKey, ReportObjectNamespaceKey,
+					"Pod Name", "Container Name"},
+				ObjectFieldsValues: []string{"Container is privileged", "ns2", "bad-pod", "test"},
+			},
+			{
+				ObjectType:         "Operator",
+				ObjectFieldsKeys:   []string{ReportObjectReasonKey, ReportObjectNamespaceKey, ReportObjectNameKey},
+				ObjectFieldsValues: []string{"Operator is not certified", "ns2", "my-operator"},
+			},
+		},
+	}
+}
+
+func TestReportObjectQuery(t *testing.T) {
+	details := getTestQueryCheckDetails()
+
+	assert.Equal(t, 1, details.Compliant().Count())
+	assert.Equal(t, 3, details.NonCompliant().Count())
+	assert.Equal(t, 1, details.NonCompliant().OfType("Pod").WithNamePrefix("bad-deployment").Count())
+	assert.Equal(t, 1, details.NonCompliant().OfType("Container").InNamespace("ns2").Named("test").Count())
+	assert.Equal(t, 0, details.NonCompliant().OfType("Container").Named("bad-pod").Count())
+	assert.Equal(t, 1, details.NonCompliant().Named("my-operator").WithReasonContaining("not certified").Count())
+	assert.Equal(t, 2, details.NonCompliant().InNamespace("ns2").Count())
+	assert.Equal(t, map[string]int{"Pod": 1, "Container": 1, "Operator": 1}, details.NonCompliant().CountByType())
+
+	query := details.NonCompliant().InNamespace("ns2")
+	assert.Len(t, query.Unexpected(), 1)
+	assert.Equal(t, "non-compliant objects with Namespace=ns2 of type Container", query.OfType("Container").String())
+	// Refining a query does not change it.
+	assert.Equal(t, 2, query.Count())
+
+	var nilDetails *CheckDetails
+
+	assert.Equal(t, 0, nilDetails.NonCompliant().Count())
+}
+
+func TestGetReportObjectReason(t *testing.T) {
+	details := getTestQueryCheckDetails()
+
+	assert.Equal(t, "HostPid is not set", GetReportObjectReason(details.CompliantObjectsOut[0]))
+	assert.Equal(t, "Operator is not certified", GetReportObjectReason(details.NonCompliantObjectsOut[2]))
+	assert.Equal(t, "", GetReportObjectReason(&ReportObject{ObjectType: "Pod"}))
+}
+
+func TestReportObjectQueryMatchers(t *testing.T) {
+	details := getTestQueryCheckDetails()
+
+	success, err := HaveReportObjects().Match(details.NonCompliant().OfType("Pod").InNamespace("ns1"))
+	assert.Nil(t, err)
+	assert.True(t, success)
+
+	query := details.NonCompliant().OfType("Pod").InNamespace("ns2")
+	success, err = HaveReportObjects().Match(query)
+	assert.Nil(t, err)
+	assert.False(t, success)
+	assert.Contains(t, HaveReportObjects().FailureMessage(query),
+		"Expected non-compliant objects of type Pod with Namespace=ns2 to have at least one object, found 0:")
+	assert.Contains(t, HaveReportObjects().FailureMessage(query), "Container{")
+
+	success, err = HaveReportObjectCount(2).Match(details.NonCompliant().InNamespace("ns2"))
+	assert.Nil(t, err)
+	assert.True(t, success)
+
+	query = details.NonCompliant().OfType("Pod")
+	success, err = HaveNoUnexpectedReportObjects().Match(query)
+	assert.Nil(t, err)
+	assert.False(t, success)
+
+	message := HaveNoUnexpectedReportObjects().FailureMessage(query)
+	assert.Contains(t, message, "Operator{")
+	assert.NotContains(t, message, "bad-deployment")
+
+	success, err = HaveNoUnexpectedReportObjects().Match(details.Compliant().Named("good-pod"))
+	assert.Nil(t, err)
+	assert.True(t, success)
+
+	_, err = HaveReportObjects().Match(details)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,205 @@
+package globalhelper
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-claim/pkg/claim"
+)
+
+const (
+	// ClaimDiffFieldPresence reports a test case found in only one of the claims.
+	ClaimDiffFieldPresence = "presence"
+	// ClaimDiffFieldState reports a test case state change.
+	ClaimDiffFieldState = "state"
+	// ClaimDiffFieldSkipReason reports a test case skip reason change.
+	ClaimDiffFieldSkipReason = "skip reason"
+	// ClaimDiffFieldCompliant reports compliant objects found in only one of the claims.
+	ClaimDiffFieldCompliant = "compliant objects"
+	// ClaimDiffFieldNonCompliant reports non-compliant objects found in only one of the claims.
+	ClaimDiffFieldNonCompliant = "non-compliant objects"
+)
+
+// ClaimDifference describes a test case result that differs between two claims.
+type ClaimDifference struct {
+	TestCase string `json:"testCase"`
+	Field    string `json:"field"`
+	// Base and Other hold the values found in each claim. For objects, they list the
+	// objects missing from the other claim.
+	Base  []string `json:"base"`
+	Other []string `json:"other"`
+}
+
+func (d ClaimDifference) String() string {
+	return fmt.Sprintf("%s: %s differs: %v != %v", d.TestCase, d.Field, d.Base, d.Other)
+}
+
+// DiffClaims compares the state, the skip reason and the CheckDetails objects of test cases in two claims. When
+// no test case name is given, every test case found in either claim is compared.
+func DiffClaims(base, other *claim.Root, tcNames ...string) []ClaimDifference {
+	if len(tcNames) == 0 {
+		tcNames = claimTestCaseNames(base, other)
+	}
+
+	var differences []ClaimDifference
+
+	for _, tcName := range tcNames {
+		differences = append(differences, diffTestCase(tcName, base, other)...)
+	}
+
+	return differences
+}
+
+func diffTestCase(tcName string, base, other *claim.Root) []ClaimDifference {
+	baseResult, baseErr := claimTestCaseResult(tcName, base)
+	otherResult, otherErr := claimTestCaseResult(tcName, other)
+
+	if baseErr != nil || otherErr != nil {
+		if baseErr != nil && otherErr != nil {
+			return nil
+		}
+
+		return []ClaimDifference{{
+			TestCase: tcName,
+			Field:    ClaimDiffFieldPresence,
+			Base:     []string{fmt.Sprint(baseErr == nil)},
+			Other:    []string{fmt.Sprint(otherErr == nil)},
+		}}
+	}
+
+	var differences []ClaimDifference
+
+	if baseResult.State != otherResult.State {
+		differences = append(differences, ClaimDifference{
+			TestCase: tcName,
+			Field:    ClaimDiffFieldState,
+			Base:     []string{baseResult.State},
+			Other:    []string{otherResult.State},
+		})
+	}
+
+	if baseResult.SkipReason != otherResult.SkipReason {
+		differences = append(differences, ClaimDifference{
+			TestCase: tcName,
+			Field:    ClaimDiffFieldSkipReason,
+			Base:     []string{baseResult.SkipReason},
+			Other:    []string{otherResult.SkipReason},
+		})
+	}
+
+	baseDetails := parseCheckDetailsOrEmpty(baseResult.CheckDetails)
+	otherDetails := parseCheckDetailsOrEmpty(otherResult.CheckDetails)
+
+	if difference, found := diffReportObjects(tcName, ClaimDiffFieldCompliant,
+		baseDetails.CompliantObjectsOut, otherDetails.CompliantObjectsOut); found {
+		differences = append(differences, difference)
+	}
+
+	if difference, found := diffReportObjects(tcName, ClaimDiffFieldNonCompliant,
+		baseDetails.NonCompliantObjectsOut, otherDetails.NonCompliantObjectsOut); found {
+		differences = append(differences, difference)
+	}
+
+	return differences
+}
+
+func diffReportObjects(tcName, field string, baseObjects, otherObjects []*ReportObject) (ClaimDifference, bool) {
+	onlyInBase, onlyInOther := subtractSorted(reportObjectKeys(baseObjects), reportObjectKeys(otherObjects))
+	if len(onlyInBase) == 0 && len(onlyInOther) == 0 {
+		return ClaimDifference{}, false
+	}
+
+	return ClaimDifference{TestCase: tcName, Field: field, Base: onlyInBase, Other: onlyInOther}, true
+}
+
+// reportObjectKeys returns a sorted canonical representation of the report objects.
+func reportObjectKeys(objects []*ReportObject) []string {
+	keys := make([]string, 0, len(objects))
+
+	for _, object := range objects {
+		if object == nil {
+			continue
+		}
+
+		fields := make([]string, 0, len(object.ObjectFieldsKeys))
+		for index, key := range object.ObjectFieldsKeys {
+			value := ""
+			if index < len(object.ObjectFieldsValues) {
+				value = object.ObjectFieldsValues[index]
+			}
+
+			fields = append(fields, key+"="+value)
+		}
+
+		sort.Strings(fields)
+		keys = append(keys, object.ObjectType+"{"+strings.Join(fields, ", ")+"}")
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// subtractSorted returns the elements found only in the first and only in the second sorted list,
+// duplicates included.
+func subtractSorted(first, second []string) (onlyInFirst, onlyInSecond []string) {
+	firstIndex, secondIndex := 0, 0
+
+	for firstIndex < len(first) && secondIndex < len(second) {
+		switch {
+		case first[firstIndex] == second[secondIndex]:
+			firstIndex++
+			secondIndex++
+		case first[firstIndex] < second[secondIndex]:
+			onlyInFirst = append(onlyInFirst, first[firstIndex])
+			firstIndex++
+		default:
+			onlyInSecond = append(onlyInSecond, second[secondIndex])
+			secondIndex++
+		}
+	}
+
+	onlyInFirst = append(onlyInFirst, first[firstIndex:]...)
+	onlyInSecond = append(onlyInSecond, second[secondIndex:]...)
+
+	return onlyInFirst, onlyInSecond
+}
+
+func claimTestCaseResult(tcName string, claimRoot *claim.Root) (*claim.Result, error) {
+	if claimRoot == nil || claimRoot.Claim == nil {
+		return nil, fmt.Errorf("claim is empty")
+	}
+
+	return getTestCaseResult(tcName, *claimRoot)
+}
+
+func parseCheckDetailsOrEmpty(checkDetailsStr string) *CheckDetails {
+	details, err := ParseCheckDetails(checkDetailsStr)
+	if err != nil {
+		return &CheckDetails{}
+	}
+
+	return details
+}
+
+func claimTestCaseNames(claims ...*claim.Root) []string {
+	var tcNames []string
+
+	for _, claimRoot := range claims {
+		if claimRoot == nil || claimRoot.Claim == nil {
+			continue
+		}
+
+		for tcName := range claimRoot.Claim.Results {
+			if !slices.Contains(tcNames, tcName) {
+				tcNames = append(tcNames, tcName)
+			}
+		}
+	}
+
+	sort.Strings(tcNames)
+
+	return tcNames
+}
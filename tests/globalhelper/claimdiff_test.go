@@ -0,0 +1,73 @@
+package globalhelper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-claim/pkg/claim"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/stretchr/testify/assert"
+)
+
+func generateDiffClaim(t *testing.T, results map[string]claim.Result) *claim.Root {
+	t.Helper()
+
+	return &claim.Root{Claim: &claim.Claim{Results: results}}
+}
+
+func generateCheckDetails(t *testing.T, compliant, nonCompliant []*ReportObject) string {
+	t.Helper()
+
+	encoded, err := json.Marshal(CheckDetails{CompliantObjectsOut: compliant, NonCompliantObjectsOut: nonCompliant})
+	assert.Nil(t, err)
+
+	return string(encoded)
+}
+
+func TestDiffClaims(t *testing.T) {
+	podA := &ReportObject{ObjectType: "Pod", ObjectFieldsKeys: []string{"Name", "Namespace"},
+		ObjectFieldsValues: []string{"a", "ns"}}
+	podB := &ReportObject{ObjectType: "Pod", ObjectFieldsKeys: []string{"Namespace", "Name"},
+		ObjectFieldsValues: []string{"ns", "b"}}
+	podBReordered := &ReportObject{ObjectType: "Pod", ObjectFieldsKeys: []string{"Name", "Namespace"},
+		ObjectFieldsValues: []string{"b", "ns"}}
+
+	base := generateDiffClaim(t, map[string]claim.Result{
+		"access-control-pod-host-pid": {State: globalparameters.TestCasePassed,
+			CheckDetails: generateCheckDetails(t, []*ReportObject{podA, podB}, nil)},
+		"access-control-pod-host-ipc":  {State: globalparameters.TestCasePassed},
+		"access-control-pod-host-path": {State: globalparameters.TestCaseSkipped},
+		"access-control-pod-host-network": {State: globalparameters.TestCaseSkipped,
+			SkipReason: "no pods to check found"},
+	})
+	other := generateDiffClaim(t, map[string]claim.Result{
+		"access-control-pod-host-pid": {State: globalparameters.TestCasePassed,
+			CheckDetails: generateCheckDetails(t, []*ReportObject{podBReordered, podA}, nil)},
+		"access-control-pod-host-ipc": {State: globalparameters.TestCaseFailed,
+			CheckDetails: generateCheckDetails(t, nil, []*ReportObject{podA})},
+		"access-control-pod-host-network": {State: globalparameters.TestCaseSkipped,
+			SkipReason: "no containers to check found"},
+	})
+
+	assert.Empty(t, DiffClaims(base, other, "access-control-pod-host-pid"))
+	assert.Empty(t, DiffClaims(base, other, "access-control-pod-host-pidns"))
+
+	differences := DiffClaims(base, other)
+	assert.Equal(t, []ClaimDifference{
+		{TestCase: "access-control-pod-host-ipc", Field: ClaimDiffFieldState,
+			Base: []string{globalparameters.TestCasePassed}, Other: []string{globalparameters.TestCaseFailed}},
+		{TestCase: "access-control-pod-host-ipc", Field: ClaimDiffFieldNonCompliant,
+			Other: []string{"Pod{Name=a, Namespace=ns}"}},
+		{TestCase: "access-control-pod-host-network", Field: ClaimDiffFieldSkipReason,
+			Base: []string{"no pods to check found"}, Other: []string{"no containers to check found"}},
+		{TestCase: "access-control-pod-host-path", Field: ClaimDiffFieldPresence,
+			Base: []string{"true"}, Other: []string{"false"}},
+	}, differences)
+	assert.Equal(t, "access-control-pod-host-ipc: state differs: [passed] != [failed]", differences[0].String())
+}
+
+func TestSubtractSorted(t *testing.T) {
+	onlyInFirst, onlyInSecond := subtractSorted([]string{"a", "a", "b", "d"}, []string{"a", "c", "d", "e"})
+	assert.Equal(t, []string{"a", "b"}, onlyInFirst)
+	assert.Equal(t, []string{"c", "e"}, onlyInSecond)
+}
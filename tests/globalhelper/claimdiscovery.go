@@ -0,0 +1,264 @@
+package globalhelper
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-claim/pkg/claim"
+	klog "k8s.io/klog/v2"
+)
+
+// Kinds of the objects certsuite records as discovered in the claim configurations.
+const (
+	ClaimObjectKindPod      = "Pod"
+	ClaimObjectKindOperator = "Operator"
+	ClaimObjectKindCrd      = "CustomResourceDefinition"
+)
+
+// claimDiscoveryKeys are the claim configurations keys of the discovered objects of each kind, the
+// json tags of the certsuite test environment. A claim without them fails rather than being read
+// under another key.
+var claimDiscoveryKeys = map[string]string{
+	ClaimObjectKindPod:      "testPods",
+	ClaimObjectKindOperator: "testOperators",
+	ClaimObjectKindCrd:      "testCrds",
+}
+
+const (
+	claimConfigKey          = "Config"
+	claimTargetNamespaceKey = "targetNameSpaces"
+	claimNodeSummaryKey     = "nodeSummary"
+)
+
+// ClaimObject is an object certsuite discovered, as recorded in the claim. Namespace is empty for
+// cluster scoped objects.
+type ClaimObject struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (o ClaimObject) String() string {
+	if o.Namespace == "" {
+		return fmt.Sprintf("%s %s", o.Kind, o.Name)
+	}
+
+	return fmt.Sprintf("%s %s/%s", o.Kind, o.Namespace, o.Name)
+}
+
+// ClaimDiscovery lists by name the objects certsuite is expected to discover in a namespace. A nil
+// list is not checked, an empty one expects no object of that kind.
+type ClaimDiscovery struct {
+	Pods      []string
+	Operators []string
+	// Crds are cluster scoped, all the discovered CRDs are compared.
+	Crds []string
+}
+
+// GetClaimDiscoveredObjects returns the objects of a kind certsuite discovered, read from the claim
+// configurations. It fails when the claim does not record that kind at all.
+func GetClaimDiscoveredObjects(claimReport claim.Root, kind string) ([]ClaimObject, error) {
+	key, found := claimDiscoveryKeys[kind]
+	if !found {
+		return nil, fmt.Errorf("unknown claim object kind %q", kind)
+	}
+
+	if claimReport.Claim == nil {
+		return nil, errors.New("claim section is missing")
+	}
+
+	entries, found := claimReport.Claim.Configurations[key]
+	if !found {
+		return nil, fmt.Errorf("claim configurations have no %s section", key)
+	}
+
+	// A null section is an empty list.
+	if entries == nil {
+		return nil, nil
+	}
+
+	list, isList := entries.([]interface{})
+	if !isList {
+		return nil, fmt.Errorf("claim configurations %s section is not a list", key)
+	}
+
+	objects := make([]ClaimObject, 0, len(list))
+
+	for _, entry := range list {
+		fields, isMap := entry.(map[string]interface{})
+		if !isMap {
+			return nil, fmt.Errorf("claim configurations %s section has a %T entry", key, entry)
+		}
+
+		namespace, name := claimObjectMeta(fields)
+		objects = append(objects, ClaimObject{Kind: kind, Namespace: namespace, Name: name})
+	}
+
+	return objects, nil
+}
+
+// GetClaimTargetNamespaces returns the target namespaces of the certsuite configuration echoed in
+// the claim.
+func GetClaimTargetNamespaces(claimReport claim.Root) ([]string, error) {
+	if claimReport.Claim == nil {
+		return nil, errors.New("claim section is missing")
+	}
+
+	config, found := claimReport.Claim.Configurations[claimConfigKey]
+	configFields, isMap := config.(map[string]interface{})
+
+	if !found || !isMap {
+		return nil, fmt.Errorf("claim configurations have no %s section", claimConfigKey)
+	}
+
+	list, _ := configFields[claimTargetNamespaceKey].([]interface{})
+
+	namespaces := make([]string, 0, len(list))
+
+	for _, entry := range list {
+		switch namespace := entry.(type) {
+		case string:
+			namespaces = append(namespaces, namespace)
+		case map[string]interface{}:
+			_, name := claimObjectMeta(namespace)
+			namespaces = append(namespaces, name)
+		}
+	}
+
+	return namespaces, nil
+}
+
+// GetClaimNodeNames returns the names of the nodes summarized in the claim nodes section.
+func GetClaimNodeNames(claimReport claim.Root) ([]string, error) {
+	if claimReport.Claim == nil {
+		return nil, errors.New("claim section is missing")
+	}
+
+	summary, found := claimReport.Claim.Nodes[claimNodeSummaryKey]
+	nodes, isMap := summary.(map[string]interface{})
+
+	if !found || !isMap {
+		return nil, fmt.Errorf("claim nodes have no %s section", claimNodeSummaryKey)
+	}
+
+	names := make([]string, 0, len(nodes))
+	for name := range nodes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// ValidateClaimDiscovery checks that certsuite targeted a namespace and discovered exactly the
+// expected pods and operators in it, and the expected CRDs. Claims generated by the fake launcher
+// record no discovery and are not checked.
+func ValidateClaimDiscovery(reportDir, namespace string, expected ClaimDiscovery) error {
+	claimReport, err := OpenClaimReport(reportDir)
+	if err != nil {
+		return fmt.Errorf("failed to open certsuite claim report, err: %w", err)
+	}
+
+	if claimReport.Claim.Versions != nil && claimReport.Claim.Versions.CertSuite == fakeCertsuiteVersion {
+		klog.V(5).Infof("Not validating the discovery of the fake launcher claim in %s", reportDir)
+
+		return nil
+	}
+
+	return validateClaimDiscovery(*claimReport, namespace, expected)
+}
+
+func validateClaimDiscovery(claimReport claim.Root, namespace string, expected ClaimDiscovery) error {
+	var problems []string
+
+	targetNamespaces, err := GetClaimTargetNamespaces(claimReport)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(targetNamespaces, namespace) {
+		problems = append(problems, fmt.Sprintf("namespace %s is not in the target namespaces %v", namespace, targetNamespaces))
+	}
+
+	for _, check := range []struct {
+		kind      string
+		expected  []string
+		namespace string
+	}{
+		{ClaimObjectKindPod, expected.Pods, namespace},
+		{ClaimObjectKindOperator, expected.Operators, namespace},
+		{ClaimObjectKindCrd, expected.Crds, ""},
+	} {
+		if check.expected == nil {
+			continue
+		}
+
+		objects, err := GetClaimDiscoveredObjects(claimReport, check.kind)
+		if err != nil {
+			return err
+		}
+
+		var discovered []string
+
+		for _, object := range objects {
+			if check.namespace == "" || object.Namespace == check.namespace {
+				discovered = append(discovered, object.Name)
+			}
+		}
+
+		missing, unexpected := diffNames(check.expected, discovered)
+
+		if len(missing) > 0 {
+			problems = append(problems, fmt.Sprintf("%s not discovered: %s", check.kind, strings.Join(missing, ", ")))
+		}
+
+		if len(unexpected) > 0 {
+			problems = append(problems, fmt.Sprintf("%s unexpectedly discovered: %s", check.kind, strings.Join(unexpected, ", ")))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("certsuite discovery in namespace %s does not match: %s", namespace, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// claimObjectMeta returns the namespace and name of a claim object, from its kubernetes metadata
+// or from the name and namespace fields certsuite adds to its own types.
+func claimObjectMeta(fields map[string]interface{}) (string, string) {
+	if metadata, isMap := fields["metadata"].(map[string]interface{}); isMap {
+		fields = metadata
+	}
+
+	namespace, _ := fields["namespace"].(string)
+	name, _ := fields["name"].(string)
+
+	return namespace, name
+}
+
+// diffNames returns the expected names missing from actual, and the actual names not expected.
+func diffNames(expected, actual []string) ([]string, []string) {
+	var missing, unexpected []string
+
+	for _, name := range expected {
+		if !slices.Contains(actual, name) {
+			missing = append(missing, name)
+		}
+	}
+
+	for _, name := range actual {
+		if !slices.Contains(expected, name) {
+			unexpected = append(unexpected, name)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+
+	return missing, unexpected
+}
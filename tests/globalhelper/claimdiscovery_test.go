@@ -0,0 +1,113 @@
+package globalhelper
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-claim/pkg/claim"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/stretchr/testify/assert"
+)
+
+const testClaimConfigurations = `{
+	"Config": {"targetNameSpaces": [{"name": "qe-ns"}]},
+	"testPods": [
+		{"metadata": {"name": "dep-1", "namespace": "qe-ns"}},
+		{"metadata": {"name": "dep-2", "namespace": "qe-ns"}},
+		{"metadata": {"name": "other", "namespace": "other-ns"}}
+	],
+	"testOperators": [{"name": "memcached-operator.v0.0.1", "namespace": "qe-ns"}],
+	"testCrds": null
+}`
+
+func newTestDiscoveryClaim(t *testing.T) claim.Root {
+	t.Helper()
+
+	var configurations map[string]interface{}
+
+	assert.Nil(t, json.Unmarshal([]byte(testClaimConfigurations), &configurations))
+
+	return claim.Root{Claim: &claim.Claim{
+		Configurations: configurations,
+		Nodes:          map[string]interface{}{"nodeSummary": map[string]interface{}{"worker-1": nil, "master-0": nil}},
+	}}
+}
+
+func TestGetClaimDiscoveredObjects(t *testing.T) {
+	claimReport := newTestDiscoveryClaim(t)
+
+	pods, err := GetClaimDiscoveredObjects(claimReport, ClaimObjectKindPod)
+	assert.Nil(t, err)
+	assert.Len(t, pods, 3)
+	assert.Equal(t, "Pod qe-ns/dep-1", pods[0].String())
+
+	operators, err := GetClaimDiscoveredObjects(claimReport, ClaimObjectKindOperator)
+	assert.Nil(t, err)
+	assert.Equal(t, []ClaimObject{{Kind: ClaimObjectKindOperator, Namespace: "qe-ns", Name: "memcached-operator.v0.0.1"}},
+		operators)
+
+	crds, err := GetClaimDiscoveredObjects(claimReport, ClaimObjectKindCrd)
+	assert.Nil(t, err)
+	assert.Empty(t, crds)
+
+	delete(claimReport.Claim.Configurations, "testCrds")
+
+	_, err = GetClaimDiscoveredObjects(claimReport, ClaimObjectKindCrd)
+	assert.EqualError(t, err, "claim configurations have no testCrds section")
+
+	// Only the certsuite keys are read, a renamed section is a regression.
+	claimReport.Claim.Configurations["TestPods"] = claimReport.Claim.Configurations["testPods"]
+	delete(claimReport.Claim.Configurations, "testPods")
+
+	_, err = GetClaimDiscoveredObjects(claimReport, ClaimObjectKindPod)
+	assert.EqualError(t, err, "claim configurations have no testPods section")
+
+	_, err = GetClaimDiscoveredObjects(claimReport, "Service")
+	assert.NotNil(t, err)
+
+	namespaces, err := GetClaimTargetNamespaces(claimReport)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"qe-ns"}, namespaces)
+
+	nodes, err := GetClaimNodeNames(claimReport)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"master-0", "worker-1"}, nodes)
+
+	claimReport.Claim.Configurations["config"] = claimReport.Claim.Configurations["Config"]
+	delete(claimReport.Claim.Configurations, "Config")
+
+	_, err = GetClaimTargetNamespaces(claimReport)
+	assert.EqualError(t, err, "claim configurations have no Config section")
+}
+
+func TestValidateClaimDiscovery(t *testing.T) {
+	claimReport := newTestDiscoveryClaim(t)
+
+	assert.Nil(t, validateClaimDiscovery(claimReport, "qe-ns", ClaimDiscovery{
+		Pods:      []string{"dep-2", "dep-1"},
+		Operators: []string{"memcached-operator.v0.0.1"},
+		Crds:      []string{},
+	}))
+
+	err := validateClaimDiscovery(claimReport, "qe-ns", ClaimDiscovery{Pods: []string{"dep-1", "dep-3"}, Crds: []string{"crd"}})
+	assert.EqualError(t, err, "certsuite discovery in namespace qe-ns does not match: Pod not discovered: dep-3; "+
+		"Pod unexpectedly discovered: dep-2; CustomResourceDefinition not discovered: crd")
+
+	err = validateClaimDiscovery(claimReport, "other-ns", ClaimDiscovery{})
+	assert.EqualError(t, err, "certsuite discovery in namespace other-ns does not match: "+
+		"namespace other-ns is not in the target namespaces [qe-ns]")
+}
+
+func TestValidateClaimDiscoveryOfFakeClaim(t *testing.T) {
+	reportDir := t.TempDir()
+
+	assert.Nil(t, writeFakeClaim(filepath.Join(reportDir, globalparameters.DefaultClaimFileName), map[string]string{
+		"access-control-pod-host-pid": globalparameters.TestCasePassed,
+	}))
+	assert.Nil(t, ValidateClaimDiscovery(reportDir, "qe-ns", ClaimDiscovery{Pods: []string{"dep-1"}}))
+
+	assert.Nil(t, os.Remove(filepath.Join(reportDir, globalparameters.DefaultClaimFileName)))
+	assert.NotNil(t, ValidateClaimDiscovery(reportDir, "qe-ns", ClaimDiscovery{}))
+}
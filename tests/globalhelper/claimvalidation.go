@@ -0,0 +1,332 @@
+package globalhelper
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-claim/pkg/claim"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const (
+	// claimSchemaURL is the name the embedded claim schema is compiled under.
+	claimSchemaURL = "claim.schema.json"
+	// claimTimeLayout is the layout certsuite writes the claim metadata times with, instead of the
+	// RFC 3339 date-time the schema declares.
+	claimTimeLayout = "2006-01-02 15:04:05 -0700 MST"
+)
+
+// claimSchemaContent is the claim schema of the certsuite-claim module version in go.mod, refreshed
+// by "make update-claim-schema": the module schemas folder is not vendored.
+//
+//go:embed schemas/claim.schema.json
+var claimSchemaContent []byte
+
+var claimFormatVersionRegex = regexp.MustCompile(`^v?(\d+)\.(\d+)\.(\d+)`)
+
+// claimSchema returns the compiled claim schema.
+var claimSchema = sync.OnceValues(func() (*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	compiler.Formats["date-time"] = isClaimTime
+
+	err := compiler.AddResource(claimSchemaURL, bytes.NewReader(claimSchemaContent))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the claim schema: %w", err)
+	}
+
+	schema, err := compiler.Compile(claimSchemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the claim schema: %w", err)
+	}
+
+	return schema, nil
+})
+
+// ClaimValidation is the outcome of validating a claim file against the certsuite-claim schema and model.
+type ClaimValidation struct {
+	// FormatVersion is the claim format version recorded in the claim.
+	FormatVersion string
+	// FormatProblem is set when the claim format version is invalid or not compatible with
+	// globalparameters.ClaimFormatVersion.
+	FormatProblem string
+	// Problems make the claim unusable: malformed content, schema violations or missing sections.
+	Problems []string
+	// UnknownFields are the fields not part of the certsuite-claim model, usually written by a newer
+	// certsuite claim format.
+	UnknownFields []string
+}
+
+// Err returns an error listing the problems of the claim, its format problem included, nil when
+// the claim is valid.
+func (v *ClaimValidation) Err() error {
+	problems := v.Problems
+	if v.FormatProblem != "" {
+		problems = append([]string{v.FormatProblem}, problems...)
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("invalid claim (format version %q, supported %s): %s", v.FormatVersion,
+		globalparameters.ClaimFormatVersion, strings.Join(problems, "; "))
+}
+
+// ValidateClaim checks a claim file content: it must be valid against the certsuite-claim schema,
+// hold a results section, record a claim format compatible with globalparameters.ClaimFormatVersion
+// and unmarshal into the certsuite-claim model, which has no room for unknown fields.
+func ValidateClaim(content []byte) *ClaimValidation {
+	_, validation := validateClaim(content)
+
+	return validation
+}
+
+// validateClaim validates a claim file content and returns the claim read, nil if the content
+// does not unmarshal into the certsuite-claim model.
+func validateClaim(content []byte) (*claim.Root, *ClaimValidation) {
+	validation := &ClaimValidation{}
+
+	var rawRoot struct {
+		Claim map[string]json.RawMessage `json:"claim"`
+	}
+
+	err := json.Unmarshal(content, &rawRoot)
+	if err != nil {
+		validation.Problems = append(validation.Problems, fmt.Sprintf("malformed json: %v", err))
+
+		return nil, validation
+	}
+
+	if rawRoot.Claim == nil {
+		validation.Problems = append(validation.Problems, `missing "claim" section`)
+
+		return nil, validation
+	}
+
+	var rawContent interface{}
+
+	// The content is known to be valid json at this point.
+	_ = json.Unmarshal(content, &rawContent)
+	validation.Problems = append(validation.Problems, claimSchemaProblems(rawContent)...)
+
+	// The schema does not require the results section, every certsuite run writes it.
+	if value, found := rawRoot.Claim["results"]; !found || bytes.Equal(value, []byte("null")) {
+		validation.Problems = append(validation.Problems, `missing "results" section`)
+	}
+
+	var versions struct {
+		ClaimFormat string `json:"claimFormat"`
+	}
+
+	if json.Unmarshal(rawRoot.Claim["versions"], &versions) == nil && versions.ClaimFormat != "" {
+		validation.FormatVersion = versions.ClaimFormat
+
+		err = checkClaimFormatVersion(validation.FormatVersion)
+		if err != nil {
+			validation.FormatProblem = err.Error()
+		}
+	}
+
+	validation.UnknownFields = unknownFields(rawContent, reflect.TypeOf(claim.Root{}), "")
+	sort.Strings(validation.UnknownFields)
+
+	var claimRoot claim.Root
+
+	err = json.Unmarshal(content, &claimRoot)
+	if err != nil {
+		// The model error names a single field without its path, list every unknown field instead.
+		if len(validation.UnknownFields) > 0 {
+			validation.Problems = append(validation.Problems, fmt.Sprintf("fields unknown to the claim model: %s",
+				strings.Join(validation.UnknownFields, ", ")))
+		} else {
+			validation.Problems = append(validation.Problems, fmt.Sprintf("does not match the claim model: %v", err))
+		}
+
+		return nil, validation
+	}
+
+	return &claimRoot, validation
+}
+
+// claimSchemaProblems returns the violations of the claim schema by a decoded claim, as
+// "schema: <json pointer>: <message>".
+func claimSchemaProblems(rawContent interface{}) []string {
+	schema, err := claimSchema()
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	err = schema.Validate(rawContent)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return []string{fmt.Sprintf("schema: %v", err)}
+	}
+
+	var problems []string
+
+	var collect func(validationErr *jsonschema.ValidationError)
+
+	collect = func(validationErr *jsonschema.ValidationError) {
+		if len(validationErr.Causes) == 0 {
+			location := validationErr.InstanceLocation
+			if location == "" {
+				location = "/"
+			}
+
+			problems = append(problems, fmt.Sprintf("schema: %s: %s", location, validationErr.Message))
+		}
+
+		for _, cause := range validationErr.Causes {
+			collect(cause)
+		}
+	}
+
+	collect(validationErr)
+
+	return problems
+}
+
+// isClaimTime checks the date-time format of the schema, accepting the certsuite time layout.
+func isClaimTime(value interface{}) bool {
+	text, isString := value.(string)
+	if !isString {
+		return true
+	}
+
+	for _, layout := range []string{time.RFC3339, claimTimeLayout} {
+		if _, err := time.Parse(layout, text); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkClaimFormatVersion returns an error when a claim format is not compatible with
+// globalparameters.ClaimFormatVersion.
+func checkClaimFormatVersion(formatVersion string) error {
+	major, minor, err := parseClaimFormatVersion(formatVersion)
+	if err != nil {
+		return err
+	}
+
+	supportedMajor, supportedMinor, err := parseClaimFormatVersion(globalparameters.ClaimFormatVersion)
+	if err != nil {
+		return err
+	}
+
+	if major != supportedMajor || (major == 0 && minor != supportedMinor) {
+		return fmt.Errorf("incompatible claim format version %s", formatVersion)
+	}
+
+	return nil
+}
+
+func parseClaimFormatVersion(formatVersion string) (major, minor int, err error) {
+	matches := claimFormatVersionRegex.FindStringSubmatch(formatVersion)
+	if matches == nil {
+		return 0, 0, fmt.Errorf("invalid claim format version %q", formatVersion)
+	}
+
+	major, _ = strconv.Atoi(matches[1])
+	minor, _ = strconv.Atoi(matches[2])
+
+	return major, minor, nil
+}
+
+// unknownFields walks a decoded json value along the go type it is read into, and returns the
+// paths of the object keys that type has no field for.
+func unknownFields(value interface{}, valueType reflect.Type, path string) []string {
+	for valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+
+	var unknown []string
+
+	switch valueType.Kind() {
+	case reflect.Struct:
+		object, isObject := value.(map[string]interface{})
+		if !isObject {
+			return nil
+		}
+
+		fields := jsonFieldTypes(valueType)
+
+		for key, fieldValue := range object {
+			fieldType, found := fields[key]
+			if !found {
+				unknown = append(unknown, joinFieldPath(path, key))
+
+				continue
+			}
+
+			unknown = append(unknown, unknownFields(fieldValue, fieldType, joinFieldPath(path, key))...)
+		}
+	case reflect.Map:
+		object, isObject := value.(map[string]interface{})
+		if !isObject {
+			return nil
+		}
+
+		for key, elemValue := range object {
+			unknown = append(unknown, unknownFields(elemValue, valueType.Elem(), joinFieldPath(path, key))...)
+		}
+	case reflect.Slice, reflect.Array:
+		list, isList := value.([]interface{})
+		if !isList {
+			return nil
+		}
+
+		for index, elemValue := range list {
+			unknown = append(unknown, unknownFields(elemValue, valueType.Elem(), fmt.Sprintf("%s[%d]", path, index))...)
+		}
+	default:
+	}
+
+	return unknown
+}
+
+// jsonFieldTypes returns the types of the fields of a struct by json name.
+func jsonFieldTypes(structType reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+
+	for index := 0; index < structType.NumField(); index++ {
+		field := structType.Field(index)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" || !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fields[name] = field.Type
+	}
+
+	return fields
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
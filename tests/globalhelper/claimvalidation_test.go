@@ -0,0 +1,155 @@
+package globalhelper
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/stretchr/testify/assert"
+)
+
+const validTestClaim = `{
+  "claim": {
+    "configurations": {},
+    "metadata": {"startTime": "2024-01-01T00:00:00Z", "endTime": "2024-01-01T00:01:00Z"},
+    "nodes": {},
+    "results": {
+      "access-control-pod-host-pid": {
+        "capturedTestOutput": "",
+        "catalogInfo": {"bestPracticeReference": "", "description": "", "exceptionProcess": "", "remediation": ""},
+        "categoryClassification": {},
+        "checkDetails": "",
+        "duration": 0,
+        "failureLineContent": "",
+        "failureLocation": "",
+        "skipReason": "",
+        "startTime": "2024-01-01T00:00:00Z",
+        "endTime": "2024-01-01T00:01:00Z",
+        "state": "passed",
+        "testID": {"id": "access-control-pod-host-pid", "suite": "access-control"}
+      }
+    },
+    "versions": {"certSuite": "v5.4.0", "claimFormat": "v0.5.0"}
+  }
+}`
+
+func TestValidateClaim(t *testing.T) {
+	validation := ValidateClaim([]byte(validTestClaim))
+	assert.Nil(t, validation.Err())
+	assert.Equal(t, "v0.5.0", validation.FormatVersion)
+	assert.Empty(t, validation.UnknownFields)
+
+	testCases := []struct {
+		name            string
+		content         string
+		expectedProblem string
+	}{
+		{name: "malformed json", content: `{"claim": `, expectedProblem: "malformed json"},
+		{name: "no claim", content: `{}`, expectedProblem: `missing "claim" section`},
+		{
+			name:            "no results",
+			content:         `{"claim": {"configurations": {}, "nodes": {}, "metadata": {}, "versions": {"claimFormat": "v0.5.0"}}}`,
+			expectedProblem: `missing "results" section`,
+		},
+		{
+			name:            "null nodes",
+			content:         `{"claim": {"configurations": {}, "nodes": null, "results": {}, "versions": {"claimFormat": "v0.5.0"}}}`,
+			expectedProblem: "schema: /claim/nodes: expected object, but got null",
+		},
+		{
+			name:            "no versions",
+			content:         `{"claim": {"configurations": {}, "nodes": {}, "results": {}, "metadata": {}}}`,
+			expectedProblem: "schema: /claim: missing properties: 'versions'",
+		},
+		{
+			name:            "invalid metadata time",
+			content:         strings.Replace(validTestClaim, `"endTime": "2024-01-01T00:01:00Z"}`, `"endTime": "yesterday"}`, 1),
+			expectedProblem: "schema: /claim/metadata/endTime: 'yesterday' is not valid 'date-time'",
+		},
+		{
+			name: "incompatible format",
+			content: `{"claim": {"configurations": {}, "nodes": {}, "results": {}, "metadata": {},
+				"versions": {"claimFormat": "v0.6.1"}}}`,
+			expectedProblem: "incompatible claim format version v0.6.1",
+		},
+		{
+			name: "invalid format",
+			content: `{"claim": {"configurations": {}, "nodes": {}, "results": {}, "metadata": {},
+				"versions": {"claimFormat": "latest"}}}`,
+			expectedProblem: `invalid claim format version "latest"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateClaim([]byte(testCase.content)).Err()
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), testCase.expectedProblem)
+		})
+	}
+}
+
+func TestValidateClaimCertsuiteTimes(t *testing.T) {
+	// certsuite writes the metadata times with its own layout, not RFC 3339.
+	content := strings.Replace(validTestClaim,
+		`"metadata": {"startTime": "2024-01-01T00:00:00Z", "endTime": "2024-01-01T00:01:00Z"}`,
+		`"metadata": {"startTime": "2024-01-01 00:00:00 +0000 UTC", "endTime": "2024-01-01 00:01:00 +0000 UTC"}`, 1)
+
+	assert.Nil(t, ValidateClaim([]byte(content)).Err())
+}
+
+func TestValidateClaimFormatProblem(t *testing.T) {
+	validation := ValidateClaim([]byte(strings.Replace(validTestClaim, `"claimFormat": "v0.5.0"`,
+		`"claimFormat": "v0.6.0"`, 1)))
+	assert.Empty(t, validation.Problems)
+	assert.Equal(t, "incompatible claim format version v0.6.0", validation.FormatProblem)
+	assert.NotNil(t, validation.Err())
+}
+
+func TestValidateClaimUnknownFields(t *testing.T) {
+	content := strings.NewReplacer(
+		`"nodes": {},`, `"nodes": {"node1": {"free": "form"}}, "newSection": [],`,
+		`"state": "passed",`, `"state": "passed", "newField": "value",`,
+		`"claimFormat": "v0.5.0"`, `"claimFormat": "v0.5.3", "certSuiteBuild": "x"`,
+	).Replace(validTestClaim)
+
+	validation := ValidateClaim([]byte(content))
+	assert.NotNil(t, validation.Err())
+	assert.Contains(t, validation.Err().Error(), "fields unknown to the claim model: claim.newSection, ")
+	assert.Equal(t, "v0.5.3", validation.FormatVersion)
+	assert.Equal(t, []string{
+		"claim.newSection",
+		"claim.results.access-control-pod-host-pid.newField",
+		"claim.versions.certSuiteBuild",
+	}, validation.UnknownFields)
+}
+
+func TestOpenClaimFileValidation(t *testing.T) {
+	reportDir := t.TempDir()
+	claimPath := filepath.Join(reportDir, globalparameters.DefaultClaimFileName)
+
+	assert.Nil(t, os.WriteFile(claimPath, []byte(validTestClaim), 0600))
+
+	claimRoot, err := OpenClaimReport(reportDir)
+	assert.Nil(t, err)
+	assert.Contains(t, claimRoot.Claim.Results, "access-control-pod-host-pid")
+
+	assert.Nil(t, os.WriteFile(claimPath, []byte(strings.Replace(validTestClaim, `"claimFormat": "v0.5.0"`,
+		`"claimFormat": "v1.0.0"`, 1)), 0600))
+
+	_, err = OpenClaimReport(reportDir)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "incompatible claim format version v1.0.0")
+
+	claimRoot, validation, err := OpenClaimFileOfAnyFormat(claimPath)
+	assert.Nil(t, err)
+	assert.Contains(t, claimRoot.Claim.Results, "access-control-pod-host-pid")
+	assert.Equal(t, "incompatible claim format version v1.0.0", validation.FormatProblem)
+
+	assert.Nil(t, os.WriteFile(claimPath, []byte(`{"claim": {}}`), 0600))
+
+	_, _, err = OpenClaimFileOfAnyFormat(claimPath)
+	assert.NotNil(t, err)
+}
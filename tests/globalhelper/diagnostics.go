@@ -0,0 +1,363 @@
+package globalhelper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	klog "k8s.io/klog/v2"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+const (
+	// DiagnosticsDirName is the folder, in the debug folder of a failed spec, holding its diagnostics.
+	DiagnosticsDirName = "diagnostics"
+	// defaultProbeNamespace is the namespace certsuite deploys its probe pods in when its
+	// configuration does not set one.
+	defaultProbeNamespace = "certsuite"
+	// diagnosticsLogTailLines bounds the lines of each container log kept.
+	diagnosticsLogTailLines = int64(5000)
+)
+
+// specDiagnostics holds what the diagnostics of the running spec are collected from.
+type specDiagnostics struct {
+	namespaces []string
+	reportDir  string
+	configDir  string
+}
+
+var (
+	currentSpecDiagnosticsLock sync.Mutex
+	currentSpecDiagnostics     specDiagnostics
+)
+
+// RegisterDiagnosticsNamespace adds a namespace the diagnostics of the running spec are collected
+// from when it fails. The namespaces created by BeforeEachSetupWithRandomNamespace are registered.
+func RegisterDiagnosticsNamespace(namespace string) {
+	currentSpecDiagnosticsLock.Lock()
+	defer currentSpecDiagnosticsLock.Unlock()
+
+	currentSpecDiagnostics.namespaces = append(currentSpecDiagnostics.namespaces, namespace)
+}
+
+// trackSpecDiagnostics registers the random namespace and the directories of the running spec.
+func trackSpecDiagnostics(namespace, reportDir, configDir string) {
+	RegisterDiagnosticsNamespace(namespace)
+
+	currentSpecDiagnosticsLock.Lock()
+	defer currentSpecDiagnosticsLock.Unlock()
+
+	currentSpecDiagnostics.reportDir = reportDir
+	currentSpecDiagnostics.configDir = configDir
+}
+
+// collectDiagnosticsOnFailure runs after each spec of the suites run with RunSuite, before the
+// AfterEach nodes removing its namespaces, and collects their diagnostics when the spec failed.
+func collectDiagnosticsOnFailure() {
+	currentSpecDiagnosticsLock.Lock()
+	diagnostics := currentSpecDiagnostics
+	currentSpecDiagnostics = specDiagnostics{}
+	currentSpecDiagnosticsLock.Unlock()
+
+	if !CurrentSpecReport().Failed() || len(diagnostics.namespaces) == 0 {
+		return
+	}
+
+	By("Collect diagnostics of the failed spec")
+
+	dir := diagnosticsDir(diagnostics)
+
+	err := CollectDiagnostics(dir, probeNamespace(diagnostics.configDir), diagnostics.namespaces...)
+	if err != nil {
+		klog.ErrorS(err, "failed to collect some diagnostics", "dir", dir)
+	}
+
+	AddReportEntry("diagnostics", dir)
+}
+
+// diagnosticsDir returns the diagnostics folder of the spec: in the debug folder of its last
+// certsuite run, or in a folder named after its namespace when certsuite was not run.
+func diagnosticsDir(diagnostics specDiagnostics) string {
+	if value, found := launchedDebugDirs.Load(diagnostics.reportDir); found {
+		if debugDir, isString := value.(string); isString {
+			return filepath.Join(debugDir, DiagnosticsDirName)
+		}
+	}
+
+	return filepath.Join(GetConfiguration().General.ReportDirAbsPath, "Debug", diagnostics.namespaces[0], DiagnosticsDirName)
+}
+
+// probeNamespace returns the probe pods namespace of the certsuite configuration in configDir.
+func probeNamespace(configDir string) string {
+	content, err := os.ReadFile(filepath.Join(configDir, globalparameters.DefaultCertsuiteConfigFileName))
+	if err != nil {
+		return defaultProbeNamespace
+	}
+
+	var certsuiteConfig globalparameters.CertsuiteConfig
+	if yaml.Unmarshal(content, &certsuiteConfig) != nil || certsuiteConfig.ProbeDaemonSetNamespace == "" {
+		return defaultProbeNamespace
+	}
+
+	return certsuiteConfig.ProbeDaemonSetNamespace
+}
+
+// CollectDiagnostics writes in dir the events, pods statuses, container logs and workloads of the
+// namespaces, the logs of the certsuite probe pods and the node conditions. It collects as much as
+// it can and returns all the errors met.
+func CollectDiagnostics(dir, probeNamespace string, namespaces ...string) error {
+	return collectDiagnostics(GetAPIClient().K8sClient, dir, probeNamespace, namespaces...)
+}
+
+func collectDiagnostics(client kubernetes.Interface, dir, probeNamespace string, namespaces ...string) error {
+	var errs []error
+
+	for _, namespace := range namespaces {
+		errs = append(errs, collectNamespaceDiagnostics(client, filepath.Join(dir, namespace), namespace)...)
+	}
+
+	pods, err := client.CoreV1().Pods(probeNamespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to list probe pods in %s: %w", probeNamespace, err))
+	} else {
+		errs = append(errs, collectPodLogs(client, filepath.Join(dir, "probe"), pods.Items)...)
+	}
+
+	errs = append(errs, writeDiagnosticsFile(filepath.Join(dir, "nodes.txt"), func(writer io.Writer) error {
+		nodes, err := client.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list nodes: %w", err)
+		}
+
+		return writeNodeConditions(writer, nodes.Items)
+	}))
+
+	return errors.Join(errs...)
+}
+
+func collectNamespaceDiagnostics(client kubernetes.Interface, dir, namespace string) []error {
+	ctx := context.TODO()
+	listOptions := metav1.ListOptions{}
+
+	errs := []error{writeDiagnosticsFile(filepath.Join(dir, "events.txt"), func(writer io.Writer) error {
+		events, err := client.CoreV1().Events(namespace).List(ctx, listOptions)
+		if err != nil {
+			return fmt.Errorf("failed to list events: %w", err)
+		}
+
+		return writeEvents(writer, events.Items)
+	})}
+
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, listOptions)
+	if err != nil {
+		return append(errs, fmt.Errorf("failed to list pods in %s: %w", namespace, err))
+	}
+
+	errs = append(errs,
+		writeDiagnosticsFile(filepath.Join(dir, "pod-statuses.txt"), func(writer io.Writer) error {
+			return writePodStatuses(writer, pods.Items)
+		}),
+		writeDiagnosticsYAML(filepath.Join(dir, "pods.yaml"), pods))
+	errs = append(errs, collectPodLogs(client, filepath.Join(dir, "logs"), pods.Items)...)
+
+	for fileName, list := range map[string]func() (any, error){
+		"deployments.yaml":  func() (any, error) { return client.AppsV1().Deployments(namespace).List(ctx, listOptions) },
+		"statefulsets.yaml": func() (any, error) { return client.AppsV1().StatefulSets(namespace).List(ctx, listOptions) },
+		"daemonsets.yaml":   func() (any, error) { return client.AppsV1().DaemonSets(namespace).List(ctx, listOptions) },
+		"replicasets.yaml":  func() (any, error) { return client.AppsV1().ReplicaSets(namespace).List(ctx, listOptions) },
+		"services.yaml":     func() (any, error) { return client.CoreV1().Services(namespace).List(ctx, listOptions) },
+	} {
+		objects, err := list()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list the objects of %s in %s: %w", fileName, namespace, err))
+
+			continue
+		}
+
+		errs = append(errs, writeDiagnosticsYAML(filepath.Join(dir, fileName), objects))
+	}
+
+	return errs
+}
+
+// collectPodLogs writes the logs of the containers of the pods, and the logs of their previous
+// instance when they restarted, as <pod>/<container>.log and <pod>/<container>.previous.log.
+func collectPodLogs(client kubernetes.Interface, dir string, pods []corev1.Pod) []error {
+	var errs []error
+
+	for _, pod := range pods {
+		statuses := slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses)
+
+		for _, status := range statuses {
+			// A waiting container has no current instance, e.g. in CrashLoopBackOff: only the
+			// previous one, if any, has logs.
+			if status.State.Waiting == nil {
+				logPath := filepath.Join(dir, pod.Name, status.Name+".log")
+				errs = append(errs, writePodLog(client, logPath, pod, status.Name, false))
+			}
+
+			if status.RestartCount > 0 {
+				logPath := filepath.Join(dir, pod.Name, status.Name+".previous.log")
+				errs = append(errs, writePodLog(client, logPath, pod, status.Name, true))
+			}
+		}
+	}
+
+	return errs
+}
+
+func writePodLog(client kubernetes.Interface, logPath string, pod corev1.Pod, container string, previous bool) error {
+	tailLines := diagnosticsLogTailLines
+
+	logs, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: container,
+		Previous:  previous,
+		TailLines: &tailLines,
+	}).Stream(context.TODO())
+	if err != nil {
+		return fmt.Errorf("failed to get the logs of container %s of pod %s: %w", container, pod.Name, err)
+	}
+
+	defer logs.Close()
+
+	return writeDiagnosticsFile(logPath, func(writer io.Writer) error {
+		_, err := io.Copy(writer, logs)
+
+		return err
+	})
+}
+
+func writeEvents(writer io.Writer, events []corev1.Event) error {
+	sort.Slice(events, func(i, j int) bool {
+		return eventTime(events[i]).Time.Before(eventTime(events[j]).Time)
+	})
+
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(table, "TIME\tTYPE\tREASON\tOBJECT\tCOUNT\tMESSAGE")
+
+	for _, event := range events {
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s/%s\t%d\t%s\n", eventTime(event).UTC().Format("2006-01-02T15:04:05Z"),
+			event.Type, event.Reason, strings.ToLower(event.InvolvedObject.Kind), event.InvolvedObject.Name,
+			event.Count, strings.TrimSpace(event.Message))
+	}
+
+	return table.Flush()
+}
+
+// eventTime returns the last time an event was seen, from the field its reporter filled.
+func eventTime(event corev1.Event) metav1.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp
+	case event.Series != nil:
+		return metav1.NewTime(event.Series.LastObservedTime.Time)
+	case !event.EventTime.IsZero():
+		return metav1.NewTime(event.EventTime.Time)
+	default:
+		return event.CreationTimestamp
+	}
+}
+
+func writePodStatuses(writer io.Writer, pods []corev1.Pod) error {
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(table, "POD\tPHASE\tNODE\tCONTAINER\tREADY\tRESTARTS\tSTATE")
+
+	for _, pod := range pods {
+		fmt.Fprintf(table, "%s\t%s\t%s\t\t\t\t%s\n", pod.Name, pod.Status.Phase, pod.Spec.NodeName,
+			strings.TrimSpace(pod.Status.Reason+" "+pod.Status.Message))
+
+		statuses := slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses)
+		for _, status := range statuses {
+			fmt.Fprintf(table, "\t\t\t%s\t%t\t%d\t%s\n", status.Name, status.Ready, status.RestartCount,
+				containerState(status))
+		}
+	}
+
+	return table.Flush()
+}
+
+func containerState(status corev1.ContainerStatus) string {
+	state := status.State
+
+	switch {
+	case state.Waiting != nil:
+		return strings.TrimSpace(fmt.Sprintf("waiting %s %s", state.Waiting.Reason, state.Waiting.Message))
+	case state.Terminated != nil:
+		return strings.TrimSpace(fmt.Sprintf("terminated %s exit code %d %s", state.Terminated.Reason,
+			state.Terminated.ExitCode, state.Terminated.Message))
+	case state.Running != nil:
+		return "running since " + state.Running.StartedAt.UTC().Format("2006-01-02T15:04:05Z")
+	default:
+		return "unknown"
+	}
+}
+
+func writeNodeConditions(writer io.Writer, nodes []corev1.Node) error {
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(table, "NODE\tCONDITION\tSTATUS\tREASON\tMESSAGE")
+
+	for _, node := range nodes {
+		if node.Spec.Unschedulable {
+			fmt.Fprintf(table, "%s\tUnschedulable\tTrue\t\t\n", node.Name)
+		}
+
+		for _, condition := range node.Status.Conditions {
+			fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\n", node.Name, condition.Type, condition.Status, condition.Reason,
+				condition.Message)
+		}
+	}
+
+	return table.Flush()
+}
+
+func writeDiagnosticsYAML(filePath string, object any) error {
+	return writeDiagnosticsFile(filePath, func(writer io.Writer) error {
+		content, err := k8syaml.Marshal(object)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", filepath.Base(filePath), err)
+		}
+
+		_, err = writer.Write(content)
+
+		return err
+	})
+}
+
+// writeDiagnosticsFile creates a diagnostics file and its folder, and writes it with write.
+func writeDiagnosticsFile(filePath string, write func(io.Writer) error) error {
+	err := os.MkdirAll(filepath.Dir(filePath), globalparameters.DirPermissions)
+	if err != nil {
+		return fmt.Errorf("failed to create diagnostics folder %s: %w", filepath.Dir(filePath), err)
+	}
+
+	file, err := os.Create(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to create diagnostics file %s: %w", filePath, err)
+	}
+
+	defer file.Close()
+
+	err = write(file)
+	if err != nil {
+		return fmt.Errorf("failed to write diagnostics file %s: %w", filePath, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package globalhelper
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func newDiagnosticsTestPod(namespace, name string, restartCount int32) *corev1.Pod {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	pod.Status.Phase = corev1.PodRunning
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name:         "test",
+		RestartCount: restartCount,
+		State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+	}}
+
+	return pod
+}
+
+func TestCollectDiagnostics(t *testing.T) {
+	dir := t.TempDir()
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+	node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue, Reason: "KubeletReady"}}
+
+	crashLoopingPod := newDiagnosticsTestPod("test-ns", "crash-looping-pod", 3)
+	crashLoopingPod.Status.ContainerStatuses[0].State = corev1.ContainerState{
+		Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}
+
+	notStartedPod := newDiagnosticsTestPod("test-ns", "not-started-pod", 0)
+	notStartedPod.Status.ContainerStatuses[0].State = corev1.ContainerState{
+		Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}
+
+	client := k8sfake.NewClientset(
+		newDiagnosticsTestPod("test-ns", "crashing-pod", 2),
+		crashLoopingPod,
+		notStartedPod,
+		newDiagnosticsTestPod("certsuite", "certsuite-probe-abcde", 0),
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "test-ns"}},
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "event1", Namespace: "test-ns"}, Type: corev1.EventTypeWarning,
+			Reason: "BackOff", Message: "Back-off restarting failed container", Count: 3,
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "crashing-pod"}},
+		node)
+
+	err := collectDiagnostics(client, dir, "certsuite", "test-ns")
+	assert.Nil(t, err)
+
+	for _, fileName := range []string{
+		"test-ns/pods.yaml",
+		"test-ns/statefulsets.yaml",
+		"test-ns/logs/crashing-pod/test.log",
+		"test-ns/logs/crashing-pod/test.previous.log",
+		"test-ns/logs/crash-looping-pod/test.previous.log",
+		"probe/certsuite-probe-abcde/test.log",
+	} {
+		assert.FileExists(t, filepath.Join(dir, fileName))
+	}
+
+	for _, fileName := range []string{
+		"probe/certsuite-probe-abcde/test.previous.log",
+		"test-ns/logs/crash-looping-pod/test.log",
+		"test-ns/logs/not-started-pod/test.log",
+	} {
+		assert.NoFileExists(t, filepath.Join(dir, fileName))
+	}
+
+	for fileName, expected := range map[string]string{
+		"test-ns/events.txt":       "Back-off restarting failed container",
+		"test-ns/pod-statuses.txt": "crashing-pod",
+		"test-ns/deployments.yaml": "name: test-deployment",
+		"nodes.txt":                "KubeletReady",
+	} {
+		content, err := os.ReadFile(filepath.Join(dir, fileName))
+		assert.Nil(t, err)
+		assert.Contains(t, string(content), expected, fileName)
+	}
+}
+
+func TestWriteEvents(t *testing.T) {
+	now := time.Now()
+	events := []corev1.Event{
+		{Reason: "Second", LastTimestamp: metav1.NewTime(now)},
+		{Reason: "First", LastTimestamp: metav1.NewTime(now.Add(-time.Minute))},
+		{Reason: "Third", EventTime: metav1.NewMicroTime(now.Add(time.Minute))},
+	}
+
+	var output bytes.Buffer
+
+	assert.Nil(t, writeEvents(&output, events))
+
+	content := output.String()
+	assert.Less(t, strings.Index(content, "First"), strings.Index(content, "Second"))
+	assert.Less(t, strings.Index(content, "Second"), strings.Index(content, "Third"))
+}
+
+func TestContainerState(t *testing.T) {
+	testCases := []struct {
+		state    corev1.ContainerState
+		expected string
+	}{
+		{corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}, "waiting ImagePullBackOff"},
+		{corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}},
+			"terminated Error exit code 1"},
+		{corev1.ContainerState{}, "unknown"},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, containerState(corev1.ContainerStatus{State: testCase.state}))
+	}
+}
+
+func TestProbeNamespace(t *testing.T) {
+	configDir := t.TempDir()
+	assert.Equal(t, defaultProbeNamespace, probeNamespace(configDir))
+
+	assert.Nil(t, NewCertsuiteConfig("ns1").WithProbeDaemonSetNamespace("probe-ns").Write(configDir))
+	assert.Equal(t, "probe-ns", probeNamespace(configDir))
+}
+
+func TestDiagnosticsDir(t *testing.T) {
+	originalConf := conf
+
+	defer func() { conf = originalConf }()
+
+	conf = &config.Config{}
+	conf.General.ReportDirAbsPath = "/reports"
+
+	diagnostics := specDiagnostics{namespaces: []string{"test-ns-abcdefghij"}, reportDir: "/tmp/test-ns-abcdefghij"}
+	assert.Equal(t, "/reports/Debug/test-ns-abcdefghij/"+DiagnosticsDirName, diagnosticsDir(diagnostics))
+
+	launchedDebugDirs.Store(diagnostics.reportDir, "/reports/Debug/access-control/access_control_pod_host_pid")
+
+	defer launchedDebugDirs.Delete(diagnostics.reportDir)
+
+	assert.Equal(t, "/reports/Debug/access-control/access_control_pod_host_pid/"+DiagnosticsDirName,
+		diagnosticsDir(diagnostics))
+}
+
+func TestTrackSpecDiagnostics(t *testing.T) {
+	defer func() { currentSpecDiagnostics = specDiagnostics{} }()
+
+	trackSpecDiagnostics("test-ns", "/tmp/report", "/tmp/config")
+	RegisterDiagnosticsNamespace("additional-ns")
+
+	assert.Equal(t, specDiagnostics{namespaces: []string{"test-ns", "additional-ns"}, reportDir: "/tmp/report",
+		configDir: "/tmp/config"}, currentSpecDiagnostics)
+}
@@ -0,0 +1,364 @@
+package globalhelper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	machineconfigv1 "github.com/openshift/api/machineconfiguration/v1"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	klog "k8s.io/klog/v2"
+)
+
+// DoctorStatus is the result of a doctor check. The statuses are ordered by severity.
+type DoctorStatus string
+
+const (
+	// DoctorPass is a check the environment meets.
+	DoctorPass DoctorStatus = "pass"
+	// DoctorWarn is a check that makes some specs skip or fail, the run can go on.
+	DoctorWarn DoctorStatus = "warn"
+	// DoctorFail is a check that makes the run fail or hang, fix it before running the suites.
+	DoctorFail DoctorStatus = "fail"
+)
+
+// doctorImageTimeout bounds the time an image registry has to answer.
+const doctorImageTimeout = time.Minute
+
+// leftoverNamespacePattern matches the namespaces created by BeforeEachSetupWithRandomNamespace
+// before they were labeled with their owner: a base name and a suffix of 10 random lowercase letters.
+var leftoverNamespacePattern = regexp.MustCompile(`^[a-z0-9-]+-[a-z]{10}$`)
+
+// leftoverNamespaceIgnoredPrefixes are the prefixes of the platform namespaces.
+var leftoverNamespaceIgnoredPrefixes = []string{"openshift-", "kube-"}
+
+// DoctorCheck is the result of one check of the environment.
+type DoctorCheck struct {
+	Name    string       `json:"name"`
+	Status  DoctorStatus `json:"status"`
+	Message string       `json:"message"`
+}
+
+// DoctorReport lists the checks of the environment. Its verdict is the most severe status of
+// the checks.
+type DoctorReport struct {
+	Verdict DoctorStatus  `json:"verdict"`
+	Checks  []DoctorCheck `json:"checks"`
+}
+
+func (r *DoctorReport) add(check DoctorCheck) {
+	r.Checks = append(r.Checks, check)
+
+	if doctorSeverity(check.Status) > doctorSeverity(r.Verdict) {
+		r.Verdict = check.Status
+	}
+}
+
+func doctorSeverity(status DoctorStatus) int {
+	return slices.Index([]DoctorStatus{DoctorPass, DoctorWarn, DoctorFail}, status)
+}
+
+// DoctorImages returns the images the suites pull: the certsuite image, for the container
+// launcher, and the workload images.
+func DoctorImages() []string {
+	images := []string{
+		globalparameters.UBIMicroImage,
+		globalparameters.CertsuiteSampleWorkloadImage,
+		globalparameters.DebugImage,
+	}
+
+	general := GetConfiguration().General
+	if GetConfiguration().LauncherName() == globalparameters.ContainerLauncherName {
+		images = append([]string{general.CertsuiteImage + ":" + general.CertsuiteImageTag}, images...)
+	}
+
+	return images
+}
+
+// RunDoctor checks everything the suites rely on in the environment: the configuration, the
+// container engine, the images, the nodes and their labels, the catalog sources, the machine
+// config pools and the namespaces left by previous runs. The checks only read the cluster.
+func RunDoctor(images []string) *DoctorReport {
+	report := &DoctorReport{Verdict: DoctorPass}
+
+	report.add(checkDoctorConfiguration(ValidateConfiguration()))
+
+	engineCheck := checkContainerEngine()
+	report.add(engineCheck)
+
+	for _, image := range images {
+		if engineCheck.Status != DoctorPass {
+			report.add(DoctorCheck{"image " + image, DoctorWarn, "not checked, the container engine is not available"})
+
+			continue
+		}
+
+		report.add(checkImage(GetConfiguration().General.ContainerEngine, image))
+	}
+
+	nodes, err := GetAPIClient().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		// Without the API server, the cluster checks would all fail the same way.
+		report.add(DoctorCheck{"cluster", DoctorFail, fmt.Sprintf("failed to list nodes: %v", err)})
+
+		return report
+	}
+
+	general := GetConfiguration().General
+	report.add(checkNodes(nodes.Items))
+	report.add(checkNodeLabel("worker label", nodes.Items, general.WorkerNodeLabel, DoctorFail))
+	report.add(checkNodeLabel("worker-cnf label", nodes.Items, general.CnfNodeLabel, DoctorWarn))
+
+	capabilities := ProbeClusterCapabilities()
+	report.add(checkDoctorCatalogSources(capabilities.Has(CapabilityOLM)))
+	report.add(checkDoctorMachineConfigPools(capabilities.Has(CapabilityMCO)))
+	report.add(checkDoctorNamespaces())
+
+	return report
+}
+
+func checkDoctorConfiguration(err error) DoctorCheck {
+	var validationErr *config.ValidationError
+	if errors.As(err, &validationErr) {
+		problems := make([]string, 0, len(validationErr.Problems))
+		for _, problem := range validationErr.Problems {
+			problems = append(problems, problem.String())
+		}
+
+		return DoctorCheck{"configuration", DoctorFail, strings.Join(problems, "; ")}
+	}
+
+	if err != nil {
+		return DoctorCheck{"configuration", DoctorFail, err.Error()}
+	}
+
+	return DoctorCheck{"configuration", DoctorPass, "launcher " + GetConfiguration().LauncherName()}
+}
+
+// checkContainerEngine checks that the container engine answers. Only the container launcher
+// fails without it, the other launchers use it to check the images.
+func checkContainerEngine() DoctorCheck {
+	engine := GetConfiguration().General.ContainerEngine
+
+	status := DoctorWarn
+	if GetConfiguration().LauncherName() == globalparameters.ContainerLauncherName {
+		status = DoctorFail
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), doctorImageTimeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, engine, "version").CombinedOutput()
+	if err != nil {
+		return DoctorCheck{"container engine", status,
+			fmt.Sprintf("%s is not available: %v %s", engine, err, strings.TrimSpace(string(output)))}
+	}
+
+	return DoctorCheck{"container engine", DoctorPass, engine + " is available"}
+}
+
+// checkImage checks that the image manifest can be read from its registry, without pulling it.
+func checkImage(engine, image string) DoctorCheck {
+	if err := inspectImage(engine, image); err != nil {
+		return DoctorCheck{"image " + image, DoctorFail, fmt.Sprintf("cannot be pulled: %v", err)}
+	}
+
+	return DoctorCheck{"image " + image, DoctorPass, "pullable"}
+}
+
+// inspectImage reads the image manifest from its registry with the container engine.
+var inspectImage = func(engine, image string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), doctorImageTimeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, engine, "manifest", "inspect", image).CombinedOutput()
+	if err != nil {
+		klog.V(5).Infof("%s manifest inspect %s: %s", engine, image, output)
+
+		return err
+	}
+
+	return nil
+}
+
+// inspectLocalImage checks that the image is in the local storage of the container engine.
+var inspectLocalImage = func(engine, image string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), doctorImageTimeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, engine, "image", "inspect", image).CombinedOutput()
+	if err != nil {
+		klog.V(5).Infof("%s image inspect %s: %s", engine, image, output)
+
+		return err
+	}
+
+	return nil
+}
+
+// checkNodes fails when a node is not ready or is cordoned.
+func checkNodes(nodes []corev1.Node) DoctorCheck {
+	var notReady, unschedulable []string
+
+	for _, node := range nodes {
+		ready := slices.ContainsFunc(node.Status.Conditions, func(condition corev1.NodeCondition) bool {
+			return condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue
+		})
+
+		if !ready {
+			notReady = append(notReady, node.Name)
+		}
+
+		if node.Spec.Unschedulable {
+			unschedulable = append(unschedulable, node.Name)
+		}
+	}
+
+	var problems []string
+
+	if len(notReady) > 0 {
+		problems = append(problems, "not ready: "+strings.Join(notReady, ", "))
+	}
+
+	if len(unschedulable) > 0 {
+		problems = append(problems, "unschedulable: "+strings.Join(unschedulable, ", "))
+	}
+
+	if len(nodes) == 0 {
+		problems = append(problems, "the cluster has no nodes")
+	}
+
+	if len(problems) > 0 {
+		return DoctorCheck{"nodes", DoctorFail, strings.Join(problems, "; ")}
+	}
+
+	return DoctorCheck{"nodes", DoctorPass, fmt.Sprintf("%d nodes ready and schedulable", len(nodes))}
+}
+
+// checkNodeLabel checks that the label selector of the configuration selects nodes, with the
+// status given when it selects none.
+func checkNodeLabel(name string, nodes []corev1.Node, selector string, missingStatus DoctorStatus) DoctorCheck {
+	if selector == "" {
+		return DoctorCheck{name, missingStatus, "not configured"}
+	}
+
+	parsedSelector, err := labels.Parse(selector)
+	if err != nil {
+		return DoctorCheck{name, DoctorFail, fmt.Sprintf("invalid label selector %q: %v", selector, err)}
+	}
+
+	var selected int
+
+	for _, node := range nodes {
+		if parsedSelector.Matches(labels.Set(node.Labels)) {
+			selected++
+		}
+	}
+
+	if selected == 0 {
+		return DoctorCheck{name, missingStatus, fmt.Sprintf("no node is labeled %s", selector)}
+	}
+
+	return DoctorCheck{name, DoctorPass, fmt.Sprintf("%d nodes labeled %s", selected, selector)}
+}
+
+func checkDoctorCatalogSources(hasOLM bool) DoctorCheck {
+	if !hasOLM {
+		return DoctorCheck{"catalog sources", DoctorWarn, "OLM is not installed, the operator specs will fail"}
+	}
+
+	notReady, err := notReadyCatalogSource(context.TODO(), GetAPIClient().OperatorsV1alpha1Interface, requiredCatalogSources)
+	if err != nil {
+		return DoctorCheck{"catalog sources", DoctorFail, fmt.Sprintf("failed to list catalog sources: %v", err)}
+	}
+
+	if notReady != "" {
+		return DoctorCheck{"catalog sources", DoctorFail, notReady}
+	}
+
+	return DoctorCheck{"catalog sources", DoctorPass, strings.Join(requiredCatalogSources, ", ") + " are READY"}
+}
+
+func checkDoctorMachineConfigPools(hasMCO bool) DoctorCheck {
+	if !hasMCO {
+		return DoctorCheck{"machine config pools", DoctorPass, "no MCO on the cluster"}
+	}
+
+	pools, err := GetAPIClient().MachineConfigPools().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return DoctorCheck{"machine config pools", DoctorFail, fmt.Sprintf("failed to list machine config pools: %v", err)}
+	}
+
+	return checkMachineConfigPools(pools.Items)
+}
+
+// checkMachineConfigPools fails when a pool is updating or degraded: its nodes are rebooting, or
+// will not get new machine configs.
+func checkMachineConfigPools(pools []machineconfigv1.MachineConfigPool) DoctorCheck {
+	var problems []string
+
+	for _, pool := range pools {
+		for _, condition := range pool.Status.Conditions {
+			if condition.Status != corev1.ConditionTrue {
+				continue
+			}
+
+			if condition.Type == machineconfigv1.MachineConfigPoolUpdating || condition.Type == machineconfigv1.MachineConfigPoolDegraded {
+				problems = append(problems, fmt.Sprintf("%s is %s", pool.Name, strings.ToLower(string(condition.Type))))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return DoctorCheck{"machine config pools", DoctorFail, strings.Join(problems, ", ")}
+	}
+
+	return DoctorCheck{"machine config pools", DoctorPass, fmt.Sprintf("%d pools updated", len(pools))}
+}
+
+func checkDoctorNamespaces() DoctorCheck {
+	namespaces, err := GetAPIClient().Namespaces().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return DoctorCheck{"leftover namespaces", DoctorWarn, fmt.Sprintf("failed to list namespaces: %v", err)}
+	}
+
+	return checkLeftoverNamespaces(namespaces.Items)
+}
+
+// checkLeftoverNamespaces warns about the namespaces of previous runs, which hold workloads
+// certsuite may still discover and resources quotas are counted against.
+func checkLeftoverNamespaces(namespaces []corev1.Namespace) DoctorCheck {
+	var leftovers []string
+
+	for i := range namespaces {
+		if isLeftoverNamespace(&namespaces[i]) {
+			leftovers = append(leftovers, namespaces[i].Name)
+		}
+	}
+
+	if len(leftovers) > 0 {
+		return DoctorCheck{"leftover namespaces", DoctorWarn, strings.Join(leftovers, ", ") + ", run cmd/janitor to remove them"}
+	}
+
+	return DoctorCheck{"leftover namespaces", DoctorPass, "none"}
+}
+
+func isLeftoverNamespace(namespace *corev1.Namespace) bool {
+	if IsQENamespace(namespace) {
+		return true
+	}
+
+	return leftoverNamespacePattern.MatchString(namespace.Name) &&
+		!slices.ContainsFunc(leftoverNamespaceIgnoredPrefixes, func(prefix string) bool {
+			return strings.HasPrefix(namespace.Name, prefix)
+		})
+}
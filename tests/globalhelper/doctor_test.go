@@ -0,0 +1,128 @@
+package globalhelper
+
+import (
+	"errors"
+	"testing"
+
+	machineconfigv1 "github.com/openshift/api/machineconfiguration/v1"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newDoctorTestNode(name string, ready, unschedulable bool, labels map[string]string) corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+
+	node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	node.Spec.Unschedulable = unschedulable
+	node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}}
+
+	return node
+}
+
+func TestDoctorReportVerdict(t *testing.T) {
+	report := &DoctorReport{Verdict: DoctorPass}
+
+	report.add(DoctorCheck{"first", DoctorPass, ""})
+	assert.Equal(t, DoctorPass, report.Verdict)
+
+	report.add(DoctorCheck{"second", DoctorFail, ""})
+	report.add(DoctorCheck{"third", DoctorWarn, ""})
+	assert.Equal(t, DoctorFail, report.Verdict)
+	assert.Len(t, report.Checks, 3)
+}
+
+func TestCheckDoctorConfiguration(t *testing.T) {
+	check := checkDoctorConfiguration(&config.ValidationError{Problems: []config.Problem{
+		{Kind: config.ProblemInvalidBool, Setting: "USE_BINARY", Value: "yes", Hint: "set true or false"},
+		{Kind: config.ProblemMissingImage, Setting: "CERTSUITE_IMAGE_TAG", Hint: "set the certsuite image tag"},
+	}})
+	assert.Equal(t, DoctorFail, check.Status)
+	assert.Contains(t, check.Message, `USE_BINARY="yes"`)
+	assert.Contains(t, check.Message, "CERTSUITE_IMAGE_TAG")
+
+	assert.Equal(t, DoctorFail, checkDoctorConfiguration(errors.New("unreadable")).Status)
+}
+
+func TestCheckNodes(t *testing.T) {
+	check := checkNodes([]corev1.Node{
+		newDoctorTestNode("node1", true, false, nil),
+		newDoctorTestNode("node2", true, false, nil),
+	})
+	assert.Equal(t, DoctorCheck{"nodes", DoctorPass, "2 nodes ready and schedulable"}, check)
+
+	check = checkNodes([]corev1.Node{
+		newDoctorTestNode("node1", false, false, nil),
+		newDoctorTestNode("node2", true, true, nil),
+	})
+	assert.Equal(t, DoctorCheck{"nodes", DoctorFail, "not ready: node1; unschedulable: node2"}, check)
+
+	assert.Equal(t, DoctorFail, checkNodes(nil).Status)
+}
+
+func TestCheckNodeLabel(t *testing.T) {
+	nodes := []corev1.Node{
+		newDoctorTestNode("node1", true, false, map[string]string{"node-role.kubernetes.io/worker": ""}),
+		newDoctorTestNode("node2", true, false, map[string]string{"node-role.kubernetes.io/master": ""}),
+	}
+
+	testCases := []struct {
+		selector       string
+		expectedStatus DoctorStatus
+	}{
+		{"node-role.kubernetes.io/worker", DoctorPass},
+		{"node-role.kubernetes.io/worker-cnf", DoctorWarn},
+		{"", DoctorWarn},
+		{"invalid label!", DoctorFail},
+	}
+
+	for _, testCase := range testCases {
+		check := checkNodeLabel("label", nodes, testCase.selector, DoctorWarn)
+		assert.Equal(t, testCase.expectedStatus, check.Status, testCase.selector)
+	}
+}
+
+func TestCheckMachineConfigPools(t *testing.T) {
+	newPool := func(name string, conditionType machineconfigv1.MachineConfigPoolConditionType) machineconfigv1.MachineConfigPool {
+		pool := machineconfigv1.MachineConfigPool{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		pool.Status.Conditions = []machineconfigv1.MachineConfigPoolCondition{
+			{Type: conditionType, Status: corev1.ConditionTrue},
+			{Type: machineconfigv1.MachineConfigPoolDegraded, Status: corev1.ConditionFalse},
+		}
+
+		return pool
+	}
+
+	check := checkMachineConfigPools([]machineconfigv1.MachineConfigPool{
+		newPool("master", machineconfigv1.MachineConfigPoolUpdated),
+		newPool("worker", machineconfigv1.MachineConfigPoolUpdated),
+	})
+	assert.Equal(t, DoctorCheck{"machine config pools", DoctorPass, "2 pools updated"}, check)
+
+	check = checkMachineConfigPools([]machineconfigv1.MachineConfigPool{
+		newPool("master", machineconfigv1.MachineConfigPoolUpdated),
+		newPool("worker", machineconfigv1.MachineConfigPoolUpdating),
+	})
+	assert.Equal(t, DoctorCheck{"machine config pools", DoctorFail, "worker is updating"}, check)
+}
+
+func TestCheckLeftoverNamespaces(t *testing.T) {
+	var namespaces []corev1.Namespace
+
+	for _, name := range []string{"default", "access-control-abcdefghij", "openshift-abcdefghij", "networking-ns-qwertyuiop",
+		"operator-ns-short"} {
+		namespaces = append(namespaces, corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+
+	namespaces = append(namespaces, corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ac-test",
+		Labels: map[string]string{NamespaceOwnerLabel: NamespaceOwner}}})
+
+	assert.Equal(t, DoctorCheck{"leftover namespaces", DoctorWarn,
+		"access-control-abcdefghij, networking-ns-qwertyuiop, ac-test, run cmd/janitor to remove them"},
+		checkLeftoverNamespaces(namespaces))
+	assert.Equal(t, DoctorPass, checkLeftoverNamespaces(namespaces[:1]).Status)
+}
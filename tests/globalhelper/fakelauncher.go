@@ -0,0 +1,150 @@
+package globalhelper
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-claim/pkg/claim"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	klog "k8s.io/klog/v2"
+)
+
+const fakeCertsuiteVersion = "fake"
+
+// FakeLauncher writes a canned claim.json instead of running certsuite. It allows
+// exercising the whole spec flow, from LaunchTests to ValidateIfReportsAreValid,
+// without a cluster or a certsuite build.
+type FakeLauncher struct {
+	// Results maps certsuite test case names to the state written in the claim.
+	Results map[string]string
+	// DefaultState is used for test cases requested in the label filter but missing
+	// from Results. Defaults to passed.
+	DefaultState string
+	// ClaimFile, when set, is copied to the report directory instead of generating a claim.
+	ClaimFile string
+	// Err is returned by Launch after the claim has been written. The run is classified as an
+	// infra failure, like a launcher that could not run certsuite.
+	Err error
+
+	lock     sync.Mutex
+	requests []LaunchRequest
+}
+
+// Launch writes the canned claim.json into the request's report directory.
+func (l *FakeLauncher) Launch(request LaunchRequest) (*LaunchResult, error) {
+	l.lock.Lock()
+	l.requests = append(l.requests, request)
+	l.lock.Unlock()
+
+	claimPath := path.Join(request.ReportDir, globalparameters.DefaultClaimFileName)
+
+	klog.V(5).Infof("Fake launcher writing %s for label filter %q", claimPath, request.LabelFilter)
+
+	err := os.MkdirAll(request.ReportDir, globalparameters.DirPermissions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create report directory %s: %w", request.ReportDir, err)
+	}
+
+	if l.ClaimFile != "" {
+		err = CopyFiles(l.ClaimFile, claimPath)
+	} else {
+		err = writeFakeClaim(claimPath, l.resultsFor(request.LabelFilter))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("fake launcher failed to write claim: %w", err)
+	}
+
+	CopyClaimFileToTcFolder(request.LabelFilter, request.TcNameForReport, request.ReportDir)
+
+	result := &LaunchResult{Attempts: 1}
+	result.finish(time.Now(), l.Err, false, "", request.ReportDir)
+
+	// finish would classify the run as completed, since the claim was written.
+	if l.Err != nil {
+		result.Class = FailureClassInfra
+	}
+
+	return result, l.Err
+}
+
+// Requests returns the launch requests received so far.
+func (l *FakeLauncher) Requests() []LaunchRequest {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return append([]LaunchRequest{}, l.requests...)
+}
+
+func (l *FakeLauncher) resultsFor(labelFilter string) map[string]string {
+	defaultState := l.DefaultState
+	if defaultState == "" {
+		defaultState = globalparameters.TestCasePassed
+	}
+
+	results := map[string]string{}
+	for tcName, state := range l.Results {
+		results[tcName] = state
+	}
+
+	for _, tcName := range splitLabelFilter(labelFilter) {
+		if _, found := results[tcName]; !found {
+			results[tcName] = defaultState
+		}
+	}
+
+	return results
+}
+
+// splitLabelFilter returns the test case names of a label filter made of names joined with "||".
+func splitLabelFilter(labelFilter string) []string {
+	var tcNames []string
+
+	for _, tcName := range strings.Split(labelFilter, "||") {
+		tcName = strings.TrimSpace(tcName)
+		if tcName != "" {
+			tcNames = append(tcNames, tcName)
+		}
+	}
+
+	return tcNames
+}
+
+func writeFakeClaim(claimPath string, results map[string]string) error {
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	claimRoot := claim.Root{
+		Claim: &claim.Claim{
+			Configurations: map[string]interface{}{},
+			Nodes:          map[string]interface{}{},
+			Metadata:       &claim.Metadata{StartTime: now, EndTime: now},
+			Versions:       &claim.Versions{CertSuite: fakeCertsuiteVersion, ClaimFormat: globalparameters.ClaimFormatVersion},
+			Results:        map[string]claim.Result{},
+		},
+	}
+
+	for tcName, state := range results {
+		suiteName, _ := getTestSuiteName(tcName)
+
+		claimRoot.Claim.Results[tcName] = claim.Result{
+			CatalogInfo:            &claim.CatalogInfo{},
+			CategoryClassification: &claim.CategoryClassification{},
+			StartTime:              now,
+			EndTime:                now,
+			State:                  state,
+			TestID:                 &claim.Identifier{Id: tcName, Suite: suiteName},
+		}
+	}
+
+	encodedClaim, err := json.MarshalIndent(&claimRoot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal claim: %w", err)
+	}
+
+	return os.WriteFile(claimPath, encodedClaim, 0600)
+}
@@ -0,0 +1,87 @@
+package globalhelper
+
+import (
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeLauncherLaunch(t *testing.T) {
+	reportDir := t.TempDir()
+
+	launcher := &FakeLauncher{
+		Results: map[string]string{
+			"access-control-pod-host-pid": globalparameters.TestCaseFailed,
+		},
+	}
+
+	result, err := launcher.Launch(LaunchRequest{
+		LabelFilter: "access-control-pod-host-pid || access-control-pod-host-ipc",
+		ReportDir:   reportDir,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, launcher.Requests(), 1)
+	assert.Equal(t, FailureClassCompleted, result.Class)
+	assert.Equal(t, path.Join(reportDir, globalparameters.DefaultClaimFileName), result.ClaimPath)
+
+	assert.Nil(t, ValidateIfReportsAreValid("access-control-pod-host-pid", globalparameters.TestCaseFailed, reportDir))
+	assert.Nil(t, ValidateIfReportsAreValid("access-control-pod-host-ipc", globalparameters.TestCasePassed, reportDir))
+	assert.NotNil(t, ValidateIfReportsAreValid("access-control-pod-host-network", globalparameters.TestCasePassed, reportDir))
+}
+
+func TestFakeLauncherClaimFileAndError(t *testing.T) {
+	srcDir := t.TempDir()
+	reportDir := t.TempDir()
+
+	err := writeFakeClaim(path.Join(srcDir, "canned.json"),
+		map[string]string{"lifecycle-pod-owner-type": globalparameters.TestCaseSkipped})
+	assert.Nil(t, err)
+
+	launchErr := errors.New("failed to start certsuite")
+	launcher := &FakeLauncher{ClaimFile: path.Join(srcDir, "canned.json"), Err: launchErr}
+
+	result, err := launcher.Launch(LaunchRequest{LabelFilter: "lifecycle-pod-owner-type", ReportDir: reportDir})
+	assert.Equal(t, launchErr, err)
+	assert.Equal(t, -1, result.ExitCode)
+	assert.Equal(t, FailureClassInfra, result.Class)
+	assert.True(t, result.Retryable())
+	assert.Nil(t, ValidateIfReportsAreValid("lifecycle-pod-owner-type", globalparameters.TestCaseSkipped, reportDir))
+}
+
+func TestLaunchTestsWithFakeLauncher(t *testing.T) {
+	originalConf := conf
+
+	defer func() { conf = originalConf }()
+
+	conf = &config.Config{}
+	conf.General.Launcher = globalparameters.FakeLauncherName
+	conf.General.ReportDirAbsPath = t.TempDir()
+
+	reportDir := t.TempDir()
+
+	err := LaunchTests("observability-container-logging", "one_pod_one_container", reportDir, t.TempDir())
+	assert.Nil(t, err)
+	assert.Nil(t, ValidateIfReportsAreValid("observability-container-logging", globalparameters.TestCasePassed, reportDir))
+
+	_, err = os.Stat(path.Join(conf.General.ReportDirAbsPath, "Debug", globalparameters.ObservabilitySuiteName,
+		"one_pod_one_container", globalparameters.DefaultClaimFileName))
+	assert.Nil(t, err)
+
+	result, err := LaunchTestsWithResult("observability-container-logging", "one_pod_one_container", reportDir, t.TempDir())
+	assert.Nil(t, err)
+	assert.Equal(t, FailureClassCompleted, result.Class)
+
+	conf.General.Launcher = "non-existing"
+	assert.NotNil(t, LaunchTests("observability-container-logging", "one_pod_one_container", reportDir, t.TempDir()))
+}
+
+func TestSplitLabelFilter(t *testing.T) {
+	assert.Equal(t, []string{"a"}, splitLabelFilter("a"))
+	assert.Equal(t, []string{"a", "b"}, splitLabelFilter(" a || b "))
+	assert.Nil(t, splitLabelFilter(""))
+}
@@ -7,7 +7,6 @@ import (
 	"math/rand"
 	"os"
 	"os/exec"
-	"path"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -16,8 +15,8 @@ import (
 
 	"github.com/redhat-best-practices-for-k8s/certsuite-claim/pkg/claim"
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/rbac"
-	"gopkg.in/yaml.v3"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 )
@@ -36,7 +35,12 @@ func ValidateIfReportsAreValid(tcName string, tcExpectedStatus string, reportDir
 		return fmt.Errorf("failed to open certsuite claim report, err: %w", err)
 	}
 
-	err = IsExpectedStatusParamValid(tcExpectedStatus)
+	return validateTestCaseStatus(tcName, tcExpectedStatus, *claimReport)
+}
+
+// validateTestCaseStatus checks the state of a test case in a claim, and records it for the reports.
+func validateTestCaseStatus(tcName string, tcExpectedStatus string, claimReport claim.Root) error {
+	err := IsExpectedStatusParamValid(tcExpectedStatus)
 	if err != nil {
 		return fmt.Errorf("expected status %q is not valid, err: %w", tcExpectedStatus, err)
 	}
@@ -52,8 +56,9 @@ func ValidateIfReportsAreValid(tcName string, tcExpectedStatus string, reportDir
 	}
 
 	klog.V(5).Info("Verify test case status in claim report file")
+	recordCertsuiteResult(tcName, []string{tcExpectedStatus}, claimReport)
 
-	testPassed, err := isTestCaseInValidStatusInClaimReport(tcName, *claimReport)
+	testPassed, err := isTestCaseInValidStatusInClaimReport(tcName, claimReport)
 	if err != nil {
 		return fmt.Errorf("failed to get the state of test case %q from the claim report file, err: %w", tcName, err)
 	}
@@ -75,6 +80,7 @@ func ValidateIfReportsAreValidWithAcceptedStatuses(tcName string, acceptedStatus
 	}
 
 	klog.V(5).Info("Verify test case status in claim report file (multiple accepted statuses)")
+	recordCertsuiteResult(tcName, acceptedStatuses, *claimReport)
 
 	// Try each accepted status
 	for _, status := range acceptedStatuses {
@@ -107,45 +113,16 @@ func ValidateIfReportsAreValidWithAcceptedStatuses(tcName string, acceptedStatus
 	return fmt.Errorf("test case %q is not in any of the accepted statuses %v", tcName, acceptedStatuses)
 }
 
-// DefineCertsuiteConfig creates certsuite_config.yml file under certsuite config directory.
+// DefineCertsuiteConfig creates certsuite_config.yml file under certsuite config directory. Use
+// NewCertsuiteConfig to set the other certsuite configuration fields.
 func DefineCertsuiteConfig(namespaces []string, targetPodLabels []string, targetOperatorLabels []string,
 	certifiedContainerInfo []string, crdFilters []string, configDir string) error {
-	certsuiteConfigFilePath := path.Join(configDir, globalparameters.DefaultCertsuiteConfigFileName)
-
-	configFile, err := os.OpenFile(certsuiteConfigFilePath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
-	if err != nil {
-		return fmt.Errorf("error opening/creating file %s: %w", certsuiteConfigFilePath, err)
-	}
-
-	defer configFile.Close()
-	configFileEncoder := yaml.NewEncoder(configFile)
-	certsuiteConfig := globalparameters.CertsuiteConfig{}
-
-	// Configure the collector submission details
-	certsuiteConfig.ExecutedBy = ExecutedBy
-	certsuiteConfig.CollectorAppPassword = AppPwd
-	certsuiteConfig.PartnerName = PartnerName
-
-	// Check if job ID environment variable is set and append to partner name
-	// Example, this should look like "qeuser_1234"
-	jobID := os.Getenv("JOB_ID")
-	if jobID != "" {
-		certsuiteConfig.PartnerName = fmt.Sprintf("%s_%s", PartnerName, jobID)
-	}
-
-	err = defineCertsuiteNamespaces(&certsuiteConfig, namespaces)
-	if err != nil {
-		return fmt.Errorf("failed to create namespaces section in certsuite yaml config file: %w", err)
-	}
-
-	err = definePodUnderTestLabels(&certsuiteConfig, targetPodLabels)
+	certsuiteConfig, err := NewCertsuiteConfig(namespaces...).
+		WithPodLabels(targetPodLabels...).
+		WithOperatorLabels(targetOperatorLabels...).
+		Build()
 	if err != nil {
-		return fmt.Errorf("failed to create target pod labels section in certsuite yaml config file: %w", err)
-	}
-
-	err = defineOperatorsUnderTestLabels(&certsuiteConfig, targetOperatorLabels)
-	if err != nil {
-		return fmt.Errorf("failed to create target operator labels section in certsuite yaml config file: %w", err)
+		return err
 	}
 
 	err = defineCertifiedContainersInfo(&certsuiteConfig, certifiedContainerInfo)
@@ -159,15 +136,7 @@ func DefineCertsuiteConfig(namespaces []string, targetPodLabels []string, target
 		return fmt.Errorf("failed to create crd filters section in certsuite yaml config file: %w", err)
 	}
 
-	err = configFileEncoder.Encode(certsuiteConfig)
-	if err != nil {
-		return fmt.Errorf("failed to encode certsuite yaml config file on %s: %w", certsuiteConfigFilePath, err)
-	}
-
-	klog.V(5).Infof("%s deployed under %s directory",
-		globalparameters.DefaultCertsuiteConfigFileName, configDir)
-
-	return nil
+	return writeCertsuiteConfig(certsuiteConfig, configDir)
 }
 
 // IsExpectedStatusParamValid validates if requested test status is valid.
@@ -395,8 +364,13 @@ func GenerateRandomString(length int) string {
 
 // Returns true if the cluster is of kind type, otherwise false. Performance
 // gains are achievable by invoking the command once, leveraging a
-// synchronization mechanism like sync.Once.
+// synchronization mechanism like sync.Once. The environment profile, when
+// configured or detected, answers without running the command.
 func IsKindCluster() bool {
+	if profile, known := configuredProfile(); known {
+		return profile == config.ProfileKind
+	}
+
 	cmd := exec.CommandContext(context.TODO(),
 		"oc",
 		"cluster-info", "--context", "kind-kind",
@@ -408,6 +382,10 @@ func IsKindCluster() bool {
 // Returns true if the cluster is a CRC (Code Ready Containers) cluster, otherwise false.
 // CRC clusters are typically single-node OpenShift clusters used for development.
 func IsCRCCluster() bool {
+	if profile, known := configuredProfile(); known {
+		return profile == config.ProfileCRC
+	}
+
 	// Method 1: Check for CRC-specific domain patterns
 	cmd := exec.CommandContext(context.TODO(), "oc", "cluster-info")
 	output, err := cmd.Output()
@@ -1,9 +1,11 @@
 package globalhelper
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"os/exec"
 	"runtime"
 	"testing"
 	"time"
@@ -67,9 +69,74 @@ func GetConfiguration() *config.Config {
 		klog.Fatalf("can not load configuration - %s", err)
 	}
 
+	if conf.ProfileIsAuto() {
+		resolveAutoProfile(conf)
+	}
+
 	return conf
 }
 
+// ValidateConfiguration checks the configuration and the selected launcher, and returns all their
+// problems in a *config.ValidationError.
+func ValidateConfiguration() error {
+	var problems []config.Problem
+
+	var validationErr *config.ValidationError
+	if err := GetConfiguration().Validate(); errors.As(err, &validationErr) {
+		problems = validationErr.Problems
+	}
+
+	launcherName := GetConfiguration().LauncherName()
+	if _, err := GetLauncher(launcherName); err != nil {
+		problems = append(problems, config.Problem{Kind: config.ProblemUnknownLauncher, Setting: "CERTSUITE_LAUNCHER",
+			Value: launcherName, Hint: err.Error()})
+	}
+
+	if len(problems) > 0 {
+		return &config.ValidationError{Problems: problems}
+	}
+
+	return nil
+}
+
+// ValidateCertsuiteImage checks that the certsuite image is in the local storage of the container
+// engine, or can be read from its registry. It is meant to be called from the first function of a
+// suite SynchronizedBeforeSuite, so the registry is queried once. A missing image only fails the
+// container launcher, which runs it: with the other launchers it is a warning.
+func ValidateCertsuiteImage() error {
+	general := GetConfiguration().General
+	if general.CertsuiteImage == "" || general.CertsuiteImageTag == "" {
+		return nil
+	}
+
+	// Without the engine there is nothing to check with, ValidateConfiguration reports it when
+	// the container launcher needs it.
+	if _, err := exec.LookPath(general.ContainerEngine); err != nil {
+		return nil
+	}
+
+	image := general.CertsuiteImage + ":" + general.CertsuiteImageTag
+	if inspectLocalImage(general.ContainerEngine, image) == nil {
+		return nil
+	}
+
+	err := inspectImage(general.ContainerEngine, image)
+	if err == nil {
+		return nil
+	}
+
+	problem := config.Problem{Kind: config.ProblemImageUnreachable, Setting: "CERTSUITE_IMAGE", Value: image,
+		Hint: fmt.Sprintf("%s finds it neither locally nor in its registry: %v", general.ContainerEngine, err)}
+
+	if GetConfiguration().LauncherName() != globalparameters.ContainerLauncherName {
+		klog.Warningf("certsuite image: %s", problem)
+
+		return nil
+	}
+
+	return &config.ValidationError{Problems: []config.Problem{problem}}
+}
+
 func GenerateDirectories(randomStr string) (string, string, error) {
 	reportDir := GetConfiguration().General.CertsuiteReportDir + "/" + randomStr
 	configDir := GetConfiguration().General.CertsuiteConfigDir + "/" + randomStr
@@ -97,6 +164,8 @@ func BeforeEachSetupWithRandomNamespace(incomingNamespace string) (randomNamespa
 	randomReportDir, randomConfigDir, err = GenerateDirectories(randomNamespace)
 	Expect(err).ToNot(HaveOccurred())
 
+	trackSpecDiagnostics(randomNamespace, randomReportDir, randomConfigDir)
+
 	return randomNamespace, randomReportDir, randomConfigDir
 }
 
@@ -115,6 +184,8 @@ func BeforeEachSetupWithRandomPrivilegedNamespace(incomingNamespace string) (ran
 	randomReportDir, randomConfigDir, err = GenerateDirectories(randomNamespace)
 	Expect(err).ToNot(HaveOccurred())
 
+	trackSpecDiagnostics(randomNamespace, randomReportDir, randomConfigDir)
+
 	return randomNamespace, randomReportDir, randomConfigDir
 }
 
@@ -123,25 +194,47 @@ func RunSuite(t *testing.T, suiteName string) {
 
 	_, callerFile, _, _ := runtime.Caller(1)
 
+	currentSuiteName = suiteName
+
 	_ = flag.Lookup("logtostderr").Value.Set("true")
 	_ = flag.Lookup("v").Value.Set(GetConfiguration().General.VerificationLogLevel)
 
+	err := ValidateConfiguration()
+	if err != nil {
+		t.Fatalf("Invalid certsuite-qe configuration, fix it before running the %s suite: %v", suiteName, err)
+	}
+
 	_, reporterConfig := GinkgoConfiguration()
-	reporterConfig.JUnitReport = GetConfiguration().GetReportPath(callerFile)
+	reportPath := GetConfiguration().GetReportPath(callerFile)
+
+	// The JUnit report is written by the suite, instead of by ginkgo through reporterConfig.JUnitReport,
+	// to add the certsuite build properties to it.
+	ReportAfterSuite("junit report", func(report Report) {
+		Expect(writeJUnitReport(report, reportPath, certsuiteJUnitProperties())).To(Succeed())
+	})
+
+	// Specs labeled with RequiresCapability are skipped, before their own setup, on clusters
+	// missing a capability.
+	BeforeEach(skipUnlessCapable)
+
+	// JustAfterEach nodes run before the AfterEach nodes deleting the spec namespaces.
+	JustAfterEach(collectDiagnosticsOnFailure)
 
 	RegisterFailHandler(Fail)
 	RunSpecs(t, suiteName, reporterConfig)
 }
 
 func AfterEachCleanupWithRandomNamespace(randomNamespace, randomReportDir, randomConfigDir string, waitingTime time.Duration) {
-	// logfile := "certsuite.log"
-	// By("Print logs")
-	// myFile, err := os.ReadFile(randomReportDir + "/" + logfile)
-	// if err != nil {
-	// 	klog.Errorf("can not read file %s - %s", logfile, err)
-	// }
-	// fmt.Println(string(myFile))
-	// By(fmt.Sprintf("Remove reports from report directory: %s", randomReportDir))
+	if CurrentSpecReport().Failed() {
+		By("Preserve certsuite log of the failed spec")
+
+		err := PreserveCertsuiteLog(randomReportDir)
+		if err != nil {
+			klog.ErrorS(err, "failed to preserve certsuite log", "dir", randomReportDir)
+		}
+	}
+
+	By(fmt.Sprintf("Remove reports from report directory: %s", randomReportDir))
 	err := RemoveContentsFromReportDir(randomReportDir)
 	Expect(err).ToNot(HaveOccurred())
 
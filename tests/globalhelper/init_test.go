@@ -1 +1,92 @@
 package globalhelper
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateConfiguration(t *testing.T) {
+	originalConf := conf
+
+	defer func() { conf = originalConf }()
+
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	assert.Nil(t, os.WriteFile(kubeconfig, []byte("apiVersion: v1\nkind: Config\n"), 0600))
+	t.Setenv("KUBECONFIG", kubeconfig)
+
+	conf = &config.Config{}
+	conf.General.Launcher = globalparameters.FakeLauncherName
+
+	assert.Nil(t, ValidateConfiguration())
+
+	conf.General.Launcher = "non-existing"
+	conf.General.DisableIntrusiveTests = "maybe"
+
+	err := ValidateConfiguration()
+	assert.ErrorContains(t, err, "2 configuration problem(s)")
+	assert.ErrorContains(t, err, `unknown-launcher: CERTSUITE_LAUNCHER="non-existing", launcher "non-existing" is not registered`)
+}
+
+func TestValidateCertsuiteImage(t *testing.T) {
+	originalConf, originalInspectImage, originalInspectLocalImage := conf, inspectImage, inspectLocalImage
+
+	defer func() {
+		conf, inspectImage, inspectLocalImage = originalConf, originalInspectImage, originalInspectLocalImage
+	}()
+
+	binDir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(binDir, "podman"), []byte("#!/bin/sh\n"), 0700))
+	t.Setenv("PATH", binDir)
+
+	conf = &config.Config{}
+	conf.General.Launcher = globalparameters.ContainerLauncherName
+	conf.General.ContainerEngine = "podman"
+	conf.General.CertsuiteImage = "quay.io/example/certsuite"
+	conf.General.CertsuiteImageTag = "v0.0.0"
+
+	var inspected []string
+
+	inspectLocalImage = func(engine, image string) error {
+		inspected = append(inspected, "local "+engine+" "+image)
+
+		return errors.New("image not known")
+	}
+	inspectImage = func(engine, image string) error {
+		inspected = append(inspected, engine+" "+image)
+
+		return nil
+	}
+
+	assert.Nil(t, ValidateCertsuiteImage())
+	assert.Equal(t, []string{"local podman quay.io/example/certsuite:v0.0.0", "podman quay.io/example/certsuite:v0.0.0"},
+		inspected)
+
+	inspectImage = func(string, string) error { return errors.New("manifest unknown") }
+
+	err := ValidateCertsuiteImage()
+	assert.ErrorContains(t, err, `image-unreachable: CERTSUITE_IMAGE="quay.io/example/certsuite:v0.0.0", `+
+		"podman finds it neither locally nor in its registry: manifest unknown")
+
+	// Only a warning for the launchers not running the image.
+	conf.General.Launcher = globalparameters.BinaryLauncherName
+
+	assert.Nil(t, ValidateCertsuiteImage())
+
+	// An image only in the local storage is enough.
+	conf.General.Launcher = globalparameters.ContainerLauncherName
+	inspectLocalImage = func(string, string) error { return nil }
+
+	assert.Nil(t, ValidateCertsuiteImage())
+
+	// Not checked without the engine.
+	conf.General.ContainerEngine = "docker"
+	inspectLocalImage = func(string, string) error { return errors.New("image not known") }
+
+	assert.Nil(t, ValidateCertsuiteImage())
+}
@@ -0,0 +1,279 @@
+package globalhelper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	klog "k8s.io/klog/v2"
+)
+
+// JanitorActionKind is what the janitor does with a namespace.
+type JanitorActionKind string
+
+const (
+	// JanitorDelete deletes the namespace.
+	JanitorDelete JanitorActionKind = "delete"
+	// JanitorFinalize removes the finalizers of the objects of a namespace stuck terminating, and the
+	// ones of the namespace.
+	JanitorFinalize JanitorActionKind = "finalize"
+	// JanitorKeep keeps the namespace.
+	JanitorKeep JanitorActionKind = "keep"
+)
+
+// removeFinalizersPatch is the merge patch removing the finalizers of an object.
+var removeFinalizersPatch = []byte(`{"metadata":{"finalizers":null}}`)
+
+// legacyQENamespaceNames are the texts the names of the QE namespaces contain, the certsuite probe
+// namespace included. They select the namespaces created before they were labeled.
+var legacyQENamespaceNames = []string{"accesscontrol", "ac-test", "ac-rq-test", "my-ns", "affiliated",
+	"lifecycle-tests", "manageability", "networking", "net-tests", "observability", "operator-ns", "performance",
+	"platform-alteration", "certsuite"}
+
+// JanitorOptions selects the QE namespaces the janitor removes.
+type JanitorOptions struct {
+	// TTL is the age a namespace is removed at. Ignored when RunID is set.
+	TTL time.Duration
+	// RunID selects the namespaces of a run, whatever their age.
+	RunID string
+	// StuckAfter is the time a namespace has to be terminating for to be finalized.
+	StuckAfter time.Duration
+	// DryRun only reports the actions.
+	DryRun bool
+	// Legacy also deletes the unlabeled namespaces whose name contains one of the QE namespace
+	// names, like scripts/delete-namespaces.sh used to. They are never finalized.
+	Legacy bool
+}
+
+// JanitorAction is the action of the janitor on a QE namespace.
+type JanitorAction struct {
+	Namespace string            `json:"namespace"`
+	Kind      JanitorActionKind `json:"action"`
+	Reason    string            `json:"reason"`
+	// Err is set when the action failed.
+	Err error `json:"-"`
+}
+
+// CleanQENamespaces removes the namespaces created by certsuite-qe matching the options: it deletes
+// them, and finalizes the ones stuck terminating. It returns the actions on all the QE namespaces,
+// and an error joining the failed ones.
+func CleanQENamespaces(options JanitorOptions) ([]JanitorAction, error) {
+	return cleanQENamespaces(GetAPIClient().K8sClient, GetAPIClient().DynamicClient, options, time.Now())
+}
+
+func cleanQENamespaces(client kubernetes.Interface, dynamicClient dynamic.Interface, options JanitorOptions,
+	now time.Time) ([]JanitorAction, error) {
+	listOptions := metav1.ListOptions{LabelSelector: NamespaceOwnerLabel + "=" + NamespaceOwner}
+	if options.Legacy {
+		listOptions = metav1.ListOptions{}
+	}
+
+	namespaces, err := client.CoreV1().Namespaces().List(context.TODO(), listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list QE namespaces: %w", err)
+	}
+
+	actions := planJanitorActions(namespaces.Items, options, now)
+	if options.DryRun {
+		return actions, nil
+	}
+
+	var errs []error
+
+	for i := range actions {
+		switch actions[i].Kind {
+		case JanitorDelete:
+			err = client.CoreV1().Namespaces().Delete(context.TODO(), actions[i].Namespace, metav1.DeleteOptions{})
+			if k8serrors.IsNotFound(err) {
+				err = nil
+			}
+		case JanitorFinalize:
+			err = finalizeNamespace(client, dynamicClient, actions[i].Namespace)
+		case JanitorKeep:
+			continue
+		}
+
+		if err != nil {
+			actions[i].Err = err
+			errs = append(errs, fmt.Errorf("failed to %s namespace %s: %w", actions[i].Kind, actions[i].Namespace, err))
+		}
+	}
+
+	return actions, errors.Join(errs...)
+}
+
+// planJanitorActions returns the action on each QE namespace, sorted by namespace name.
+func planJanitorActions(namespaces []corev1.Namespace, options JanitorOptions, now time.Time) []JanitorAction {
+	var actions []JanitorAction
+
+	for i := range namespaces {
+		namespace := &namespaces[i]
+		if !IsQENamespace(namespace) && (!options.Legacy || !isLegacyQENamespace(namespace)) {
+			continue
+		}
+
+		actions = append(actions, planJanitorAction(namespace, options, now))
+	}
+
+	sort.Slice(actions, func(i, j int) bool { return actions[i].Namespace < actions[j].Namespace })
+
+	return actions
+}
+
+func planJanitorAction(namespace *corev1.Namespace, options JanitorOptions, now time.Time) JanitorAction {
+	action := JanitorAction{Namespace: namespace.Name, Kind: JanitorKeep}
+	age := now.Sub(namespaceCreatedAt(namespace)).Round(time.Second)
+
+	switch {
+	case options.RunID != "" && namespace.Labels[NamespaceRunIDLabel] != options.RunID:
+		action.Reason = fmt.Sprintf("run %s", namespace.Labels[NamespaceRunIDLabel])
+
+		return action
+	case options.RunID == "" && age < options.TTL:
+		action.Reason = fmt.Sprintf("age %s under the TTL", age)
+
+		return action
+	}
+
+	if namespace.DeletionTimestamp == nil {
+		action.Kind = JanitorDelete
+		action.Reason = fmt.Sprintf("run %s, age %s", namespace.Labels[NamespaceRunIDLabel], age)
+
+		if !IsQENamespace(namespace) {
+			action.Reason = fmt.Sprintf("legacy QE namespace, age %s", age)
+		}
+
+		return action
+	}
+
+	terminating := now.Sub(namespace.DeletionTimestamp.Time).Round(time.Second)
+
+	// An unlabeled namespace is only named like a QE one: its finalizers are left to their owner.
+	if !IsQENamespace(namespace) {
+		action.Reason = fmt.Sprintf("legacy QE namespace terminating for %s, not finalized", terminating)
+
+		return action
+	}
+
+	if terminating < options.StuckAfter {
+		action.Reason = fmt.Sprintf("terminating for %s", terminating)
+
+		return action
+	}
+
+	action.Kind = JanitorFinalize
+	action.Reason = fmt.Sprintf("stuck terminating for %s", terminating)
+
+	return action
+}
+
+// isLegacyQENamespace returns true when the name of the namespace contains one of the QE namespace
+// names.
+func isLegacyQENamespace(namespace *corev1.Namespace) bool {
+	return slices.ContainsFunc(legacyQENamespaceNames, func(name string) bool {
+		return strings.Contains(namespace.Name, name)
+	})
+}
+
+// finalizeNamespace removes the finalizers of the objects left in a terminating namespace, then the
+// ones of the namespace so that it is removed.
+func finalizeNamespace(client kubernetes.Interface, dynamicClient dynamic.Interface, name string) error {
+	resources, err := namespacedResources(client.Discovery())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, resource := range resources {
+		objects, err := dynamicClient.Resource(resource).Namespace(name).List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			klog.V(5).Infof("Failed to list %s in namespace %s: %v", resource.String(), name, err)
+
+			continue
+		}
+
+		for _, object := range objects.Items {
+			if len(object.GetFinalizers()) == 0 {
+				continue
+			}
+
+			klog.V(5).Infof("Removing finalizers %v of %s %s/%s", object.GetFinalizers(), resource.Resource, name,
+				object.GetName())
+
+			_, err = dynamicClient.Resource(resource).Namespace(name).Patch(context.TODO(), object.GetName(),
+				types.MergePatchType, removeFinalizersPatch, metav1.PatchOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to remove the finalizers of %s %s: %w", resource.Resource,
+					object.GetName(), err))
+			}
+		}
+	}
+
+	namespace, err := client.CoreV1().Namespaces().Get(context.TODO(), name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return errors.Join(errs...)
+	}
+
+	if err != nil {
+		return errors.Join(append(errs, fmt.Errorf("failed to get namespace: %w", err))...)
+	}
+
+	if len(namespace.Spec.Finalizers) > 0 {
+		namespace.Spec.Finalizers = nil
+
+		_, err = client.CoreV1().Namespaces().Finalize(context.TODO(), namespace, metav1.UpdateOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to remove the namespace finalizers: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// namespacedResources returns the namespaced resources that can be listed and patched, in one of
+// their versions. The resources of the API groups that cannot be discovered are skipped.
+func namespacedResources(discoveryClient discovery.DiscoveryInterface) ([]schema.GroupVersionResource, error) {
+	_, resourceLists, err := discoveryClient.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, fmt.Errorf("failed to discover the namespaced resources: %w", err)
+	}
+
+	var resources []schema.GroupVersionResource
+
+	for _, resourceList := range resourceLists {
+		groupVersion, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+
+		for _, resource := range resourceList.APIResources {
+			// Subresources, e.g. pods/log, are not objects.
+			if !resource.Namespaced || strings.Contains(resource.Name, "/") ||
+				!slices.Contains(resource.Verbs, "list") || !slices.Contains(resource.Verbs, "patch") {
+				continue
+			}
+
+			listed := slices.ContainsFunc(resources, func(listed schema.GroupVersionResource) bool {
+				return listed.Group == groupVersion.Group && listed.Resource == resource.Name
+			})
+			if !listed {
+				resources = append(resources, groupVersion.WithResource(resource.Name))
+			}
+		}
+	}
+
+	return resources, nil
+}
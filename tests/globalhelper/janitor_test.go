@@ -0,0 +1,145 @@
+package globalhelper
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+var janitorTestNow = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func newJanitorTestNamespace(name, runID string, age time.Duration, terminatingFor *time.Duration) *corev1.Namespace {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{
+		NamespaceOwnerLabel:     NamespaceOwner,
+		NamespaceRunIDLabel:     runID,
+		NamespaceCreatedAtLabel: strconv.FormatInt(janitorTestNow.Add(-age).Unix(), 10),
+	}}}
+
+	if terminatingFor != nil {
+		deletionTimestamp := metav1.NewTime(janitorTestNow.Add(-*terminatingFor))
+		namespace.DeletionTimestamp = &deletionTimestamp
+		namespace.Spec.Finalizers = []corev1.FinalizerName{corev1.FinalizerKubernetes}
+	}
+
+	return namespace
+}
+
+func TestPlanJanitorActions(t *testing.T) {
+	recently, longAgo := time.Minute, time.Hour
+
+	namespaces := []corev1.Namespace{
+		*newJanitorTestNamespace("old", "run1", 8*time.Hour, nil),
+		*newJanitorTestNamespace("new", "run2", time.Hour, nil),
+		*newJanitorTestNamespace("stuck", "run1", 8*time.Hour, &longAgo),
+		*newJanitorTestNamespace("terminating", "run1", 8*time.Hour, &recently),
+		{ObjectMeta: metav1.ObjectMeta{Name: "not-qe"}},
+	}
+
+	actions := planJanitorActions(namespaces, JanitorOptions{TTL: 6 * time.Hour, StuckAfter: 10 * time.Minute}, janitorTestNow)
+	assert.Equal(t, []JanitorAction{
+		{Namespace: "new", Kind: JanitorKeep, Reason: "age 1h0m0s under the TTL"},
+		{Namespace: "old", Kind: JanitorDelete, Reason: "run run1, age 8h0m0s"},
+		{Namespace: "stuck", Kind: JanitorFinalize, Reason: "stuck terminating for 1h0m0s"},
+		{Namespace: "terminating", Kind: JanitorKeep, Reason: "terminating for 1m0s"},
+	}, actions)
+
+	actions = planJanitorActions(namespaces, JanitorOptions{TTL: 6 * time.Hour, RunID: "run2"}, janitorTestNow)
+	assert.Equal(t, JanitorDelete, actions[0].Kind)
+	assert.Equal(t, JanitorKeep, actions[1].Kind)
+	assert.Equal(t, "run run1", actions[1].Reason)
+}
+
+func TestPlanJanitorActionsLegacy(t *testing.T) {
+	deletionTimestamp := metav1.NewTime(janitorTestNow.Add(-time.Hour))
+
+	namespaces := []corev1.Namespace{
+		*newJanitorTestNamespace("new", "run2", time.Hour, nil),
+		{ObjectMeta: metav1.ObjectMeta{Name: "certsuite", CreationTimestamp: metav1.NewTime(janitorTestNow.Add(-time.Minute))}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "networking-ns-abcdefghij",
+			CreationTimestamp: metav1.NewTime(janitorTestNow.Add(-2 * time.Hour))}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "openshift-monitoring"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "openshift-performance-addon", DeletionTimestamp: &deletionTimestamp,
+			CreationTimestamp: metav1.NewTime(janitorTestNow.Add(-2 * time.Hour))}},
+	}
+
+	actions := planJanitorActions(namespaces, JanitorOptions{Legacy: true, StuckAfter: 10 * time.Minute}, janitorTestNow)
+	assert.Equal(t, []JanitorAction{
+		{Namespace: "certsuite", Kind: JanitorDelete, Reason: "legacy QE namespace, age 1m0s"},
+		{Namespace: "networking-ns-abcdefghij", Kind: JanitorDelete, Reason: "legacy QE namespace, age 2h0m0s"},
+		{Namespace: "new", Kind: JanitorDelete, Reason: "run run2, age 1h0m0s"},
+		{Namespace: "openshift-performance-addon", Kind: JanitorKeep,
+			Reason: "legacy QE namespace terminating for 1h0m0s, not finalized"},
+	}, actions)
+
+	assert.Len(t, planJanitorActions(namespaces, JanitorOptions{}, janitorTestNow), 1)
+}
+
+func TestCleanQENamespaces(t *testing.T) {
+	longAgo := time.Hour
+
+	client := k8sfake.NewClientset(
+		newJanitorTestNamespace("old", "run1", 8*time.Hour, nil),
+		newJanitorTestNamespace("new", "run1", time.Hour, nil),
+		newJanitorTestNamespace("stuck", "run1", 8*time.Hour, &longAgo),
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "not-qe"}})
+
+	fakeDiscovery, isFake := client.Discovery().(*fakediscovery.FakeDiscovery)
+	assert.True(t, isFake)
+
+	fakeDiscovery.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "example.com/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "widgets", Namespaced: true, Kind: "Widget", Verbs: metav1.Verbs{"list", "patch"}},
+			{Name: "widgets/status", Namespaced: true, Kind: "Widget", Verbs: metav1.Verbs{"patch"}},
+		},
+	}}
+
+	widget := &unstructured.Unstructured{}
+	widget.SetAPIVersion("example.com/v1")
+	widget.SetKind("Widget")
+	widget.SetNamespace("stuck")
+	widget.SetName("widget")
+	widget.SetFinalizers([]string{"example.com/cleanup"})
+
+	widgets := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	dynamicClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{widgets: "WidgetList"}, widget)
+
+	options := JanitorOptions{TTL: 6 * time.Hour, StuckAfter: 10 * time.Minute}
+
+	actions, err := cleanQENamespaces(client, dynamicClient, JanitorOptions{TTL: 6 * time.Hour, DryRun: true}, janitorTestNow)
+	assert.Nil(t, err)
+	assert.Len(t, actions, 3)
+
+	_, err = client.CoreV1().Namespaces().Get(context.TODO(), "old", metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	actions, err = cleanQENamespaces(client, dynamicClient, options, janitorTestNow)
+	assert.Nil(t, err)
+	assert.Len(t, actions, 3)
+
+	_, err = client.CoreV1().Namespaces().Get(context.TODO(), "old", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	_, err = client.CoreV1().Namespaces().Get(context.TODO(), "new", metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	_, err = client.CoreV1().Namespaces().Get(context.TODO(), "not-qe", metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	updatedWidget, err := dynamicClient.Resource(widgets).Namespace("stuck").Get(context.TODO(), "widget", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Empty(t, updatedWidget.GetFinalizers())
+}
@@ -0,0 +1,521 @@
+package globalhelper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/job"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/rbac"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	klog "k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	jobClaimBeginMarker = "=====CERTSUITE-QE-CLAIM-BEGIN====="
+	jobClaimEndMarker   = "=====CERTSUITE-QE-CLAIM-END====="
+	jobConfigMountPath  = "/usr/certsuite/config"
+	jobResultsMountPath = "/usr/certsuite/results"
+	jobLogFileName      = "certsuite-job.log"
+	jobTTLAfterFinished = 600
+	jobLabelKey         = "certsuite-qe/launcher-job"
+	jobLabelFilterEnv   = "CERTSUITE_QE_LABEL_FILTER"
+
+	// jobDockerConfigMountPath holds the preflight docker config, under jobDockerConfigFileName.
+	jobDockerConfigMountPath = "/usr/certsuite/dockerconfig"
+	jobDockerConfigFileName  = "config"
+)
+
+var (
+	errJobDeadlineExceeded = errors.New("certsuite job exceeded its active deadline")
+	errJobLogsTruncated    = errors.New("certsuite job logs are truncated")
+)
+
+// jobCertsuiteScript runs certsuite and prints the resulting claim between markers so that the
+// launcher can pull it back from the pod logs. The certsuite exit code is preserved. The pod has
+// terminated when the launcher reads it, so the claim cannot be copied out of it: a claim larger
+// than the logs the kubelet keeps is lost, see errJobLogsTruncated.
+var jobCertsuiteScript = strings.Join([]string{
+	"certsuite run" +
+		" --config-file " + jobConfigMountPath + "/" + globalparameters.DefaultCertsuiteConfigFileName +
+		" --preflight-dockerconfig " + jobDockerConfigMountPath + "/" + jobDockerConfigFileName +
+		" --output-dir " + jobResultsMountPath +
+		" --omit-artifacts-zip-file true" +
+		" --enable-data-collection false" +
+		" --sanitize-claim true" +
+		" --cleanup-probe false" +
+		" --label-filter \"$" + jobLabelFilterEnv + "\"",
+	"rc=$?",
+	"echo " + jobClaimBeginMarker,
+	"cat " + jobResultsMountPath + "/" + globalparameters.DefaultClaimFileName,
+	"echo",
+	"echo " + jobClaimEndMarker,
+	"exit $rc",
+}, "; ")
+
+// jobLauncher runs the certsuite image as a Kubernetes Job inside the cluster under test.
+type jobLauncher struct{}
+
+func (l *jobLauncher) Launch(request LaunchRequest) (*LaunchResult, error) {
+	return launchTestsViaJob(GetAPIClient().K8sClient, request, request.launchPolicy().Timeout)
+}
+
+func launchTestsViaJob(client kubernetes.Interface, request LaunchRequest, timeout time.Duration) (*LaunchResult, error) {
+	namespace := GetConfiguration().General.CertsuiteJobNamespace
+
+	imageTag, err := request.imageTag()
+	if err != nil {
+		return nil, err
+	}
+
+	configFile, err := os.ReadFile(path.Join(request.ConfigDir, globalparameters.DefaultCertsuiteConfigFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read certsuite config file: %w", err)
+	}
+
+	// Like the container launcher, certsuite gets the docker config for the preflight checks.
+	dockerConfig, err := os.ReadFile(path.Join(GetConfiguration().General.DockerConfigDir, jobDockerConfigFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read preflight docker config file: %w", err)
+	}
+
+	err = ensureJobNamespace(client, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteOrphanedJobBindings(client)
+
+	files := jobFiles{certsuiteConfig: configFile, dockerConfig: dockerConfig}
+	maxAttempts := request.launchPolicy().MaxAttempts
+	result := &LaunchResult{}
+
+	// Each attempt runs a new job, only the failures classified as retryable are retried.
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		klog.V(5).Infof("Attempt %d/%d: Running certsuite job for tc: %s", attempt, maxAttempts, request.LabelFilter)
+
+		result.Attempts = attempt
+
+		err = runCertsuiteJob(client, request, namespace, imageTag, files, timeout, result)
+		if err == nil || !result.Retryable() {
+			break
+		}
+
+		klog.V(5).Infof("Attempt %d/%d failed for test: %s, %s", attempt, maxAttempts, request.LabelFilter, result)
+	}
+
+	// No job ran to its end: certsuite could not be started.
+	if result.Class == "" {
+		return nil, err
+	}
+
+	return result, err
+}
+
+// jobFiles are the files of the launcher host mounted into the certsuite job.
+type jobFiles struct {
+	certsuiteConfig []byte
+	dockerConfig    []byte
+}
+
+// runCertsuiteJob runs one certsuite job and records its outcome in result.
+func runCertsuiteJob(client kubernetes.Interface, request LaunchRequest, namespace, imageTag string, files jobFiles,
+	timeout time.Duration, result *LaunchResult) error {
+	name := "certsuite-" + GenerateRandomString(10)
+
+	certsuiteJob, err := defineCertsuiteJob(name, namespace, request.LabelFilter, imageTag, timeout)
+	if err != nil {
+		return err
+	}
+
+	klog.V(5).Infof("Running certsuite job %s/%s for tc: %s", namespace, name, request.LabelFilter)
+
+	certsuiteJob, err = client.BatchV1().Jobs(namespace).Create(context.TODO(), certsuiteJob, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create certsuite job: %w", err)
+	}
+
+	defer deleteCertsuiteJob(client, certsuiteJob)
+
+	err = createJobResources(client, certsuiteJob, files)
+	if err != nil {
+		return err
+	}
+
+	err = resumeJob(client, certsuiteJob)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+
+	jobErr := waitForJobCompletion(client, namespace, name, timeout+time.Minute)
+	timedOut := errors.Is(jobErr, context.DeadlineExceeded) || errors.Is(jobErr, errJobDeadlineExceeded)
+
+	pod, err := getJobPod(client, namespace, name)
+	if err != nil {
+		result.finish(start, jobErr, timedOut, "", request.ReportDir)
+
+		return fmt.Errorf("failed to get pod of certsuite job %s: %w (job error: %w)", name, err, jobErr)
+	}
+
+	logs, err := getJobLogs(client, pod)
+	if err != nil {
+		result.finish(start, jobErr, timedOut, podWaitingMessages(pod), request.ReportDir)
+
+		return fmt.Errorf("failed to get logs of certsuite job %s: %w (job error: %w)", name, err, jobErr)
+	}
+
+	output, claimContent, claimErr := splitJobLogs(logs)
+
+	err = writeJobOutput(request, output)
+	if err != nil {
+		return err
+	}
+
+	if claimErr == nil {
+		err = os.WriteFile(path.Join(request.ReportDir, globalparameters.DefaultClaimFileName), claimContent, 0600)
+		if err != nil {
+			return fmt.Errorf("failed to write claim file: %w", err)
+		}
+
+		CopyClaimFileToTcFolder(request.LabelFilter, request.TcNameForReport, request.ReportDir)
+	}
+
+	tail := newTailWriter(launchOutputTailSize)
+	_, _ = tail.Write(output)
+
+	if jobErr == nil {
+		jobErr = claimErr
+	}
+
+	result.finish(start, jobErr, timedOut, tail.String(), request.ReportDir)
+	result.ExitCode = jobPodExitCode(pod)
+
+	// The claim markers are printed whatever the certsuite outcome: when one of them is missing
+	// the pod logs were rotated or cut, which says nothing about certsuite.
+	if errors.Is(claimErr, errJobLogsTruncated) && !timedOut {
+		result.Class = FailureClassInfra
+	}
+
+	// The job output is only available once the pod has terminated, it is replayed to GinkgoWriter.
+	outputLogs := newCertsuiteLogWriter(GinkgoWriter)
+	_, _ = outputLogs.Write(output)
+	outputLogs.record(result)
+
+	if jobErr != nil {
+		return fmt.Errorf("failed to run tc: %s, job: %s/%s, err: %w, %s", request.LabelFilter, namespace, name,
+			jobErr, result)
+	}
+
+	return nil
+}
+
+func defineCertsuiteJob(name, namespace, labelFilter, imageTag string, timeout time.Duration) (*batchv1.Job, error) {
+	certsuiteJob := job.DefineJob(name, namespace,
+		fmt.Sprintf("%s:%s", GetConfiguration().General.CertsuiteImage, imageTag),
+		[]string{"/bin/sh", "-c", jobCertsuiteScript})
+
+	certsuiteJob.Labels = map[string]string{jobLabelKey: name}
+
+	job.RedefineWithServiceAccount(certsuiteJob, name)
+	job.RedefineWithActiveDeadline(certsuiteJob, int64(timeout.Seconds()))
+	job.RedefineWithTTLAfterFinished(certsuiteJob, jobTTLAfterFinished)
+	job.RedefineWithConfigMapVolume(certsuiteJob, name, jobConfigMountPath)
+	job.RedefineWithEmptyDirVolume(certsuiteJob, "results", jobResultsMountPath)
+	job.RedefineWithSecretVolume(certsuiteJob, "dockerconfig", name, jobDockerConfigMountPath)
+
+	env := map[string]string{jobLabelFilterEnv: labelFilter}
+
+	debugCertsuite, err := GetConfiguration().DebugCertsuite()
+	if err != nil {
+		return nil, err
+	}
+
+	if debugCertsuite {
+		env["CERTSUITE_LOG_LEVEL"] = "debug"
+	}
+
+	job.RedefineWithEnv(certsuiteJob, env)
+
+	return certsuiteJob, nil
+}
+
+func ensureJobNamespace(client kubernetes.Interface, namespace string) error {
+	_, err := client.CoreV1().Namespaces().Create(context.TODO(), &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: namespace},
+	}, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create certsuite job namespace %s: %w", namespace, err)
+	}
+
+	return nil
+}
+
+// createJobResources creates the service account, its cluster-admin binding, the config map
+// holding certsuite_config.yml and the secret holding the preflight docker config. Namespaced
+// resources are owned by the job so that they are garbage collected with it.
+func createJobResources(client kubernetes.Interface, certsuiteJob *batchv1.Job, files jobFiles) error {
+	ownerReferences := []metav1.OwnerReference{{
+		APIVersion: "batch/v1",
+		Kind:       "Job",
+		Name:       certsuiteJob.Name,
+		UID:        certsuiteJob.UID,
+	}}
+
+	_, err := client.CoreV1().ServiceAccounts(certsuiteJob.Namespace).Create(context.TODO(), &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            certsuiteJob.Name,
+			Namespace:       certsuiteJob.Namespace,
+			OwnerReferences: ownerReferences,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create certsuite job service account: %w", err)
+	}
+
+	_, err = client.CoreV1().ConfigMaps(certsuiteJob.Namespace).Create(context.TODO(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            certsuiteJob.Name,
+			Namespace:       certsuiteJob.Namespace,
+			OwnerReferences: ownerReferences,
+		},
+		Data: map[string]string{globalparameters.DefaultCertsuiteConfigFileName: string(files.certsuiteConfig)},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create certsuite job config map: %w", err)
+	}
+
+	_, err = client.CoreV1().Secrets(certsuiteJob.Namespace).Create(context.TODO(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            certsuiteJob.Name,
+			Namespace:       certsuiteJob.Namespace,
+			OwnerReferences: ownerReferences,
+		},
+		Data: map[string][]byte{jobDockerConfigFileName: files.dockerConfig},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create certsuite job docker config secret: %w", err)
+	}
+
+	// Cluster scoped objects cannot be owned by a namespaced job: the binding is labeled and
+	// removed explicitly by deleteCertsuiteJob, or by deleteOrphanedJobBindings once its job is gone.
+	binding := rbac.DefineRbacAuthorizationClusterServiceAccountSubjects(certsuiteJob.Name,
+		certsuiteJob.Namespace, certsuiteJob.Name)
+	binding.Labels = map[string]string{jobLabelKey: certsuiteJob.Name}
+	binding.RoleRef.Name = "cluster-admin"
+
+	_, err = client.RbacV1().ClusterRoleBindings().Create(context.TODO(), binding, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create certsuite job cluster role binding: %w", err)
+	}
+
+	return nil
+}
+
+func resumeJob(client kubernetes.Interface, certsuiteJob *batchv1.Job) error {
+	runningJob, err := client.BatchV1().Jobs(certsuiteJob.Namespace).Get(context.TODO(), certsuiteJob.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get certsuite job: %w", err)
+	}
+
+	runningJob.Spec.Suspend = ptr.To(false)
+
+	_, err = client.BatchV1().Jobs(certsuiteJob.Namespace).Update(context.TODO(), runningJob, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to resume certsuite job: %w", err)
+	}
+
+	return nil
+}
+
+func waitForJobCompletion(client kubernetes.Interface, namespace, name string, timeout time.Duration) error {
+	var jobFailure error
+
+	err := wait.PollUntilContextTimeout(context.TODO(), retryInterval*time.Second, timeout, true,
+		func(ctx context.Context) (bool, error) {
+			runningJob, err := client.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				klog.V(5).Infof("failed to get certsuite job %s: %v", name, err)
+
+				return false, nil
+			}
+
+			for _, condition := range runningJob.Status.Conditions {
+				if condition.Status != corev1.ConditionTrue {
+					continue
+				}
+
+				switch condition.Type {
+				case batchv1.JobComplete:
+					return true, nil
+				case batchv1.JobFailed:
+					jobFailure = fmt.Errorf("certsuite job failed: %s: %s", condition.Reason, condition.Message)
+					if condition.Reason == batchv1.JobReasonDeadlineExceeded {
+						jobFailure = fmt.Errorf("%w: %w", errJobDeadlineExceeded, jobFailure)
+					}
+
+					return true, nil
+				}
+			}
+
+			return false, nil
+		})
+	if err != nil {
+		return fmt.Errorf("certsuite job %s did not finish within %v: %w", name, timeout, err)
+	}
+
+	return jobFailure
+}
+
+func getJobPod(client kubernetes.Interface, namespace, name string) (*corev1.Pod, error) {
+	pods, err := client.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: "job-name=" + name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list certsuite job pods: %w", err)
+	}
+
+	if len(pods.Items) == 0 {
+		return nil, fmt.Errorf("no pods found for certsuite job %s", name)
+	}
+
+	return &pods.Items[0], nil
+}
+
+func getJobLogs(client kubernetes.Interface, pod *corev1.Pod) ([]byte, error) {
+	return client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{}).DoRaw(context.TODO())
+}
+
+// jobPodExitCode returns the exit code of the certsuite container, -1 if it did not terminate.
+func jobPodExitCode(pod *corev1.Pod) int {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil {
+			return int(status.State.Terminated.ExitCode)
+		}
+	}
+
+	return -1
+}
+
+// podWaitingMessages returns the reasons why the pod containers are not running, such as
+// image pull failures, so that they can be classified when no logs are available.
+func podWaitingMessages(pod *corev1.Pod) string {
+	var messages []string
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting != nil {
+			messages = append(messages, status.State.Waiting.Reason+": "+status.State.Waiting.Message)
+		}
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// splitJobLogs separates the certsuite output from the claim printed between the claim markers.
+func splitJobLogs(logs []byte) (output []byte, claimContent []byte, err error) {
+	beginIndex := bytes.Index(logs, []byte(jobClaimBeginMarker))
+	if beginIndex < 0 {
+		return logs, nil, fmt.Errorf("%w: claim begin marker not found", errJobLogsTruncated)
+	}
+
+	output = logs[:beginIndex]
+	claimContent = logs[beginIndex+len(jobClaimBeginMarker):]
+
+	endIndex := bytes.Index(claimContent, []byte(jobClaimEndMarker))
+	if endIndex < 0 {
+		return output, nil, fmt.Errorf("%w: claim end marker not found", errJobLogsTruncated)
+	}
+
+	claimContent = bytes.TrimSpace(claimContent[:endIndex])
+	if len(claimContent) == 0 {
+		return output, nil, fmt.Errorf("claim in certsuite job logs is empty")
+	}
+
+	return output, claimContent, nil
+}
+
+func writeJobOutput(request LaunchRequest, output []byte) error {
+	err := os.WriteFile(path.Join(request.ReportDir, jobLogFileName), output, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write certsuite job log: %w", err)
+	}
+
+	debugCertsuite, err := GetConfiguration().DebugCertsuite()
+	if err != nil || !debugCertsuite {
+		return err
+	}
+
+	suiteName, err := getTestSuiteName(request.LabelFilter)
+	if err != nil {
+		return fmt.Errorf("failed to create debug log file: %w", err)
+	}
+
+	outfile := GetConfiguration().CreateLogFile(suiteName, request.TcNameForReport)
+	defer outfile.Close()
+
+	_, err = fmt.Fprintf(outfile, "Running test: %s\n%s", request.TcNameForReport, output)
+	if err != nil {
+		return fmt.Errorf("failed to write to debug file: %w", err)
+	}
+
+	return nil
+}
+
+func deleteCertsuiteJob(client kubernetes.Interface, certsuiteJob *batchv1.Job) {
+	err := client.BatchV1().Jobs(certsuiteJob.Namespace).Delete(context.TODO(), certsuiteJob.Name, metav1.DeleteOptions{
+		PropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+	})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		klog.ErrorS(err, "failed to delete certsuite job", "job", certsuiteJob.Name)
+	}
+
+	err = client.RbacV1().ClusterRoleBindings().Delete(context.TODO(), certsuiteJob.Name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		klog.ErrorS(err, "failed to delete certsuite job cluster role binding", "binding", certsuiteJob.Name)
+	}
+}
+
+// deleteOrphanedJobBindings removes the cluster role bindings of the certsuite jobs that no longer
+// exist. They are left behind when the launcher is killed before deleteCertsuiteJob runs, while
+// the job itself is removed by its active deadline and TTL.
+func deleteOrphanedJobBindings(client kubernetes.Interface) {
+	bindings, err := client.RbacV1().ClusterRoleBindings().List(context.TODO(), metav1.ListOptions{LabelSelector: jobLabelKey})
+	if err != nil {
+		klog.ErrorS(err, "failed to list certsuite job cluster role bindings")
+
+		return
+	}
+
+	for _, binding := range bindings.Items {
+		if len(binding.Subjects) == 0 {
+			continue
+		}
+
+		_, err = client.BatchV1().Jobs(binding.Subjects[0].Namespace).Get(context.TODO(), binding.Labels[jobLabelKey],
+			metav1.GetOptions{})
+		if !k8serrors.IsNotFound(err) {
+			continue
+		}
+
+		klog.V(5).Infof("Deleting cluster role binding %s of removed certsuite job", binding.Name)
+
+		err = client.RbacV1().ClusterRoleBindings().Delete(context.TODO(), binding.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			klog.ErrorS(err, "failed to delete certsuite job cluster role binding", "binding", binding.Name)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package globalhelper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/rbac"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func setJobLauncherTestConfiguration(t *testing.T) {
+	t.Helper()
+
+	originalConf := conf
+
+	t.Cleanup(func() { conf = originalConf })
+
+	conf = &config.Config{}
+	conf.General.CertsuiteImage = "quay.io/test/certsuite"
+	conf.General.CertsuiteImageTag = "v1"
+	conf.General.CertsuiteJobNamespace = "certsuite-qe-runner"
+}
+
+func TestDefineCertsuiteJob(t *testing.T) {
+	setJobLauncherTestConfiguration(t)
+
+	certsuiteJob, err := defineCertsuiteJob("certsuite-abc", "certsuite-qe-runner", "access-control-pod-host-pid", "v1",
+		time.Minute)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "certsuite-abc", certsuiteJob.Spec.Template.Spec.ServiceAccountName)
+	assert.Equal(t, int64(60), *certsuiteJob.Spec.ActiveDeadlineSeconds)
+	assert.True(t, *certsuiteJob.Spec.Suspend)
+
+	container := certsuiteJob.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "quay.io/test/certsuite:v1", container.Image)
+	assert.Contains(t, container.Command[2], jobClaimBeginMarker)
+	assert.Contains(t, container.Command[2], "--preflight-dockerconfig /usr/certsuite/dockerconfig/config")
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{Name: "dockerconfig", MountPath: jobDockerConfigMountPath})
+	assert.Equal(t, "certsuite-abc", certsuiteJob.Spec.Template.Spec.Volumes[2].Secret.SecretName)
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: jobLabelFilterEnv, Value: "access-control-pod-host-pid"})
+	assert.NotContains(t, container.Env, corev1.EnvVar{Name: "CERTSUITE_LOG_LEVEL", Value: "debug"})
+
+	// DebugCertsuite sets the certsuite log level of the launcher process too.
+	t.Setenv("CERTSUITE_LOG_LEVEL", "")
+
+	conf.General.DebugCertsuite = "true"
+
+	certsuiteJob, err = defineCertsuiteJob("certsuite-abc", "certsuite-qe-runner", "access-control-pod-host-pid", "v1",
+		time.Minute)
+	assert.Nil(t, err)
+	assert.Contains(t, certsuiteJob.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "CERTSUITE_LOG_LEVEL", Value: "debug"})
+}
+
+func TestCreateJobResources(t *testing.T) {
+	setJobLauncherTestConfiguration(t)
+
+	client := k8sfake.NewClientset()
+
+	assert.Nil(t, ensureJobNamespace(client, "certsuite-qe-runner"))
+	assert.Nil(t, ensureJobNamespace(client, "certsuite-qe-runner"))
+
+	certsuiteJob, err := defineCertsuiteJob("certsuite-abc", "certsuite-qe-runner", "observability-crd-status", "v1", time.Minute)
+	assert.Nil(t, err)
+
+	certsuiteJob, err = client.BatchV1().Jobs(certsuiteJob.Namespace).Create(context.TODO(), certsuiteJob, metav1.CreateOptions{})
+	assert.Nil(t, err)
+
+	files := jobFiles{certsuiteConfig: []byte("targetNameSpaces: []"), dockerConfig: []byte(`{"auths": {}}`)}
+	assert.Nil(t, createJobResources(client, certsuiteJob, files))
+
+	configMap, err := client.CoreV1().ConfigMaps("certsuite-qe-runner").Get(context.TODO(), "certsuite-abc", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "targetNameSpaces: []", configMap.Data[globalparameters.DefaultCertsuiteConfigFileName])
+	assert.Equal(t, "Job", configMap.OwnerReferences[0].Kind)
+
+	secret, err := client.CoreV1().Secrets("certsuite-qe-runner").Get(context.TODO(), "certsuite-abc", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"auths": {}}`), secret.Data[jobDockerConfigFileName])
+	assert.Equal(t, "Job", secret.OwnerReferences[0].Kind)
+
+	serviceAccount, err := client.CoreV1().ServiceAccounts("certsuite-qe-runner").Get(context.TODO(), "certsuite-abc",
+		metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "certsuite-abc", serviceAccount.OwnerReferences[0].Name)
+
+	binding, err := client.RbacV1().ClusterRoleBindings().Get(context.TODO(), "certsuite-abc", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "cluster-admin", binding.RoleRef.Name)
+
+	assert.Nil(t, resumeJob(client, certsuiteJob))
+
+	resumedJob, err := client.BatchV1().Jobs("certsuite-qe-runner").Get(context.TODO(), "certsuite-abc", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.False(t, *resumedJob.Spec.Suspend)
+
+	deleteCertsuiteJob(client, certsuiteJob)
+
+	_, err = client.BatchV1().Jobs("certsuite-qe-runner").Get(context.TODO(), "certsuite-abc", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	_, err = client.RbacV1().ClusterRoleBindings().Get(context.TODO(), "certsuite-abc", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestWaitForJobCompletion(t *testing.T) {
+	generateJob := func(conditionType batchv1.JobConditionType) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "certsuite-abc", Namespace: "certsuite-qe-runner"},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue, Reason: "DeadlineExceeded"}},
+			},
+		}
+	}
+
+	client := k8sfake.NewClientset(generateJob(batchv1.JobComplete))
+	assert.Nil(t, waitForJobCompletion(client, "certsuite-qe-runner", "certsuite-abc", time.Second))
+
+	client = k8sfake.NewClientset(generateJob(batchv1.JobFailed))
+	err := waitForJobCompletion(client, "certsuite-qe-runner", "certsuite-abc", time.Second)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "DeadlineExceeded")
+	assert.True(t, errors.Is(err, errJobDeadlineExceeded))
+}
+
+func TestJobPodStatus(t *testing.T) {
+	pod := &corev1.Pod{}
+	assert.Equal(t, -1, jobPodExitCode(pod))
+
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "back-off"}},
+	}}
+	assert.Equal(t, "ImagePullBackOff: back-off", podWaitingMessages(pod))
+	assert.Equal(t, FailureClassImagePull, classifyFailure(errors.New("timed out"), false, podWaitingMessages(pod), false))
+
+	pod.Status.ContainerStatuses[0].State = corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 3}}
+	assert.Equal(t, 3, jobPodExitCode(pod))
+}
+
+func TestSplitJobLogs(t *testing.T) {
+	logs := []byte("INFO certsuite starting\n" + jobClaimBeginMarker + "\n{\"claim\": {}}\n\n" + jobClaimEndMarker + "\n")
+
+	output, claimContent, err := splitJobLogs(logs)
+	assert.Nil(t, err)
+	assert.Equal(t, "INFO certsuite starting\n", string(output))
+	assert.Equal(t, "{\"claim\": {}}", string(claimContent))
+
+	output, _, err = splitJobLogs([]byte("panic: something went wrong"))
+	assert.NotNil(t, err)
+	assert.Equal(t, "panic: something went wrong", string(output))
+
+	_, _, err = splitJobLogs([]byte(jobClaimBeginMarker + "\n{\"claim\""))
+	assert.True(t, errors.Is(err, errJobLogsTruncated))
+
+	_, _, err = splitJobLogs([]byte("\"claim\": {}}\n" + jobClaimEndMarker))
+	assert.True(t, errors.Is(err, errJobLogsTruncated))
+
+	_, _, err = splitJobLogs([]byte(jobClaimBeginMarker + "\n\n" + jobClaimEndMarker))
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, errJobLogsTruncated))
+}
+
+func TestDeleteOrphanedJobBindings(t *testing.T) {
+	setJobLauncherTestConfiguration(t)
+
+	newBinding := func(jobName string) *rbacv1.ClusterRoleBinding {
+		binding := rbac.DefineRbacAuthorizationClusterServiceAccountSubjects(jobName, "certsuite-qe-runner", jobName)
+		binding.Labels = map[string]string{jobLabelKey: jobName}
+
+		return binding
+	}
+
+	client := k8sfake.NewClientset(
+		newBinding("certsuite-running"),
+		newBinding("certsuite-removed"),
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "certsuite-running", Namespace: "certsuite-qe-runner"}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "unrelated"}})
+
+	deleteOrphanedJobBindings(client)
+
+	bindings, err := client.RbacV1().ClusterRoleBindings().List(context.TODO(), metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, bindings.Items, 2)
+
+	_, err = client.RbacV1().ClusterRoleBindings().Get(context.TODO(), "certsuite-removed", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
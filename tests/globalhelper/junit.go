@@ -0,0 +1,194 @@
+package globalhelper
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	klog "k8s.io/klog/v2"
+)
+
+// junitTestSuites is the ginkgo JUnit report with properties on test cases.
+type junitTestSuites struct {
+	reporters.JUnitTestSuites
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	reporters.JUnitTestSuite
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Properties *reporters.JUnitProperties `xml:"properties,omitempty"`
+	reporters.JUnitTestCase
+}
+
+// writeJUnitReport writes the ginkgo JUnit report of a suite, adds the given properties to it and
+// the Polarion ID and certsuite results of each spec to its test case.
+func writeJUnitReport(report types.Report, reportPath string, properties map[string]string) error {
+	err := reporters.GenerateJUnitReport(report, reportPath)
+	if err != nil {
+		return fmt.Errorf("failed to generate junit report %s: %w", reportPath, err)
+	}
+
+	// reporters.GenerateJUnitReport writes a test case per spec report, in the same order.
+	testCaseProperties := make([][]reporters.JUnitProperty, len(report.SpecReports))
+	for index, specReport := range report.SpecReports {
+		if polarionID := GetPolarionID(specReport.Labels()); polarionID != "" {
+			testCaseProperties[index] = append(testCaseProperties[index],
+				reporters.JUnitProperty{Name: polarionIDJUnitProperty, Value: polarionID})
+		}
+
+		testCaseProperties[index] = append(testCaseProperties[index], certsuiteResultJUnitProperties(specReport)...)
+	}
+
+	return enrichJUnitReport(reportPath, properties, testCaseProperties)
+}
+
+// enrichJUnitReport adds properties to every test suite of a JUnit report generated by ginkgo, and
+// to its test cases by index.
+func enrichJUnitReport(reportPath string, properties map[string]string, testCaseProperties [][]reporters.JUnitProperty) error {
+	content, err := os.ReadFile(reportPath)
+	if err != nil {
+		return fmt.Errorf("failed to read junit report %s: %w", reportPath, err)
+	}
+
+	var junitReport junitTestSuites
+
+	err = xml.Unmarshal(content, &junitReport)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal junit report %s: %w", reportPath, err)
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for suiteIndex := range junitReport.TestSuites {
+		suite := &junitReport.TestSuites[suiteIndex]
+
+		for _, name := range names {
+			suite.Properties.Properties = append(suite.Properties.Properties,
+				reporters.JUnitProperty{Name: name, Value: properties[name]})
+		}
+
+		for index := range suite.TestCases {
+			if index < len(testCaseProperties) && len(testCaseProperties[index]) > 0 {
+				suite.TestCases[index].Properties = &reporters.JUnitProperties{Properties: testCaseProperties[index]}
+			}
+		}
+	}
+
+	// Same encoding as ginkgo's reporters.GenerateJUnitReport.
+	var encoded strings.Builder
+
+	encoded.WriteString(xml.Header)
+
+	encoder := xml.NewEncoder(&encoded)
+	encoder.Indent("  ", "    ")
+
+	err = encoder.Encode(junitReport)
+	if err != nil {
+		return fmt.Errorf("failed to marshal junit report %s: %w", reportPath, err)
+	}
+
+	return os.WriteFile(reportPath, []byte(encoded.String()), 0600)
+}
+
+// readJUnitReports reads the JUnit XML reports of a directory. Files that are not JUnit reports
+// are ignored.
+func readJUnitReports(reportDir string) ([]junitTestSuites, error) {
+	junitFiles, err := filepath.Glob(filepath.Join(reportDir, "*.xml"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list junit reports of %s: %w", reportDir, err)
+	}
+
+	var junitReports []junitTestSuites
+
+	for _, junitFile := range junitFiles {
+		content, err := os.ReadFile(junitFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read junit report %s: %w", junitFile, err)
+		}
+
+		var junitReport junitTestSuites
+
+		err = xml.Unmarshal(content, &junitReport)
+		if err != nil {
+			klog.V(5).Infof("Ignoring %s, not a junit report: %v", junitFile, err)
+
+			continue
+		}
+
+		junitReports = append(junitReports, junitReport)
+	}
+
+	return junitReports, nil
+}
+
+// certsuiteResultJUnitProperties returns the properties of the certsuite results a spec recorded.
+func certsuiteResultJUnitProperties(specReport types.SpecReport) []reporters.JUnitProperty {
+	var properties []reporters.JUnitProperty
+
+	for _, entry := range specReport.ReportEntries {
+		if entry.Name != certsuiteResultEntryName {
+			continue
+		}
+
+		// Only the JSON value is kept when the spec ran in another parallel process.
+		result, isResult := entry.GetRawValue().(CertsuiteResult)
+		if !isResult && json.Unmarshal([]byte(entry.Value.AsJSON), &result) != nil {
+			continue
+		}
+
+		for _, property := range []reporters.JUnitProperty{
+			{Name: "certsuite.test_id", Value: result.TestCase},
+			{Name: "certsuite.expected_state", Value: strings.Join(result.Expected, ",")},
+			{Name: "certsuite.actual_state", Value: result.Actual},
+			{Name: "certsuite.skip_reason", Value: result.SkipReason},
+			{Name: "certsuite.version", Value: result.CertsuiteVersion},
+			{Name: "certsuite.image_tag", Value: result.ImageTag},
+			{Name: "cluster.version", Value: result.ClusterVersion},
+		} {
+			if property.Value != "" {
+				properties = append(properties, property)
+			}
+		}
+	}
+
+	return properties
+}
+
+// certsuiteJUnitProperties returns the properties identifying the certsuite build under test.
+func certsuiteJUnitProperties() map[string]string {
+	properties := map[string]string{
+		"certsuite.launcher": GetConfiguration().LauncherName(),
+	}
+
+	if buildInfo := GetCertsuiteBuildInfo(); buildInfo != nil {
+		properties["certsuite.commit"] = buildInfo.Commit
+		properties["certsuite.version"] = buildInfo.Version
+		properties["certsuite.dirty"] = fmt.Sprint(buildInfo.Dirty())
+		properties["certsuite.binary"] = buildInfo.BinaryPath
+	} else if GetConfiguration().LauncherName() != globalparameters.BinaryLauncherName {
+		properties["certsuite.image"] = fmt.Sprintf("%s:%s", GetConfiguration().General.CertsuiteImage,
+			GetConfiguration().General.CertsuiteImageTag)
+	}
+
+	if len(GetConfiguration().General.CertsuiteVersionMatrix) > 0 {
+		properties["certsuite.version_matrix"] = strings.Join(GetConfiguration().General.CertsuiteVersionMatrix, ",")
+	}
+
+	return properties
+}
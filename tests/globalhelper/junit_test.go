@@ -0,0 +1,161 @@
+package globalhelper
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/reporters"
+	"github.com/onsi/ginkgo/v2/types"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteJUnitReport(t *testing.T) {
+	reportPath := filepath.Join(t.TempDir(), "report.xml")
+	report := types.Report{
+		SuiteDescription: "CNFCert observability tests",
+		SuiteSucceeded:   true,
+		StartTime:        time.Now(),
+		EndTime:          time.Now(),
+		SpecReports: types.SpecReports{{
+			LeafNodeType:   types.NodeTypeIt,
+			LeafNodeText:   "observability-crd-status",
+			State:          types.SpecStatePassed,
+			StartTime:      time.Now(),
+			EndTime:        time.Now(),
+			LeafNodeLabels: []string{"observability"},
+		}},
+	}
+
+	err := writeJUnitReport(report, reportPath, map[string]string{
+		"certsuite.commit":   "1234567",
+		"certsuite.launcher": "binary",
+	})
+	assert.Nil(t, err)
+
+	content, err := os.ReadFile(reportPath)
+	assert.Nil(t, err)
+
+	var junitReport reporters.JUnitTestSuites
+
+	assert.Nil(t, xml.Unmarshal(content, &junitReport))
+	assert.Len(t, junitReport.TestSuites, 1)
+	assert.Equal(t, 1, junitReport.Tests)
+
+	properties := map[string]string{}
+	for _, property := range junitReport.TestSuites[0].Properties.Properties {
+		properties[property.Name] = property.Value
+	}
+
+	assert.Equal(t, "1234567", properties["certsuite.commit"])
+	assert.Equal(t, "binary", properties["certsuite.launcher"])
+	// Properties written by ginkgo are kept.
+	assert.Equal(t, "true", properties["SuiteSucceeded"])
+
+	var testCaseNames []string
+	for _, testCase := range junitReport.TestSuites[0].TestCases {
+		testCaseNames = append(testCaseNames, testCase.Name)
+	}
+
+	assert.Contains(t, testCaseNames, "[It] observability-crd-status [observability]")
+}
+
+func TestWriteJUnitReportTestCaseProperties(t *testing.T) {
+	reportPath := filepath.Join(t.TempDir(), "report.xml")
+	report := types.Report{
+		SuiteDescription: "CNFCert access-control tests",
+		SpecReports: types.SpecReports{
+			{
+				LeafNodeType: types.NodeTypeIt,
+				LeafNodeText: "one deployment, one pod, HostPid true [negative]",
+				State:        types.SpecStatePassed,
+				ReportEntries: types.ReportEntries{{
+					Name: certsuiteResultEntryName,
+					Value: types.WrapEntryValue(CertsuiteResult{
+						TestCase:         "access-control-pod-host-pid",
+						Expected:         []string{globalparameters.TestCaseFailed},
+						Actual:           globalparameters.TestCaseFailed,
+						CertsuiteVersion: "v5.5.0",
+						ImageTag:         "v5.5.0",
+						ClusterVersion:   "4.16.0",
+					}),
+				}},
+			},
+			{
+				LeafNodeType: types.NodeTypeIt,
+				LeafNodeText: "no certsuite result",
+				State:        types.SpecStatePassed,
+			},
+			{
+				LeafNodeType: types.NodeTypeIt,
+				LeafNodeText: "skipped test case",
+				State:        types.SpecStatePassed,
+				ReportEntries: types.ReportEntries{{
+					Name: certsuiteResultEntryName,
+					// Entry of a spec run in another parallel process.
+					Value: types.ReportEntryValue{
+						AsJSON: `{"testCase":"access-control-pod-host-ipc","expected":["skipped"],` +
+							`"actual":"skipped","skipReason":"no pods to check"}`,
+					},
+				}},
+			},
+		},
+	}
+
+	assert.Nil(t, writeJUnitReport(report, reportPath, nil))
+
+	content, err := os.ReadFile(reportPath)
+	assert.Nil(t, err)
+
+	var junitReport junitTestSuites
+
+	assert.Nil(t, xml.Unmarshal(content, &junitReport))
+	assert.Len(t, junitReport.TestSuites, 1)
+
+	testCases := junitReport.TestSuites[0].TestCases
+	assert.Len(t, testCases, 3)
+
+	assert.NotNil(t, testCases[0].Properties)
+	assert.Equal(t, []reporters.JUnitProperty{
+		{Name: "certsuite.test_id", Value: "access-control-pod-host-pid"},
+		{Name: "certsuite.expected_state", Value: globalparameters.TestCaseFailed},
+		{Name: "certsuite.actual_state", Value: globalparameters.TestCaseFailed},
+		{Name: "certsuite.version", Value: "v5.5.0"},
+		{Name: "certsuite.image_tag", Value: "v5.5.0"},
+		{Name: "cluster.version", Value: "4.16.0"},
+	}, testCases[0].Properties.Properties)
+
+	assert.Nil(t, testCases[1].Properties)
+
+	assert.NotNil(t, testCases[2].Properties)
+	assert.Contains(t, testCases[2].Properties.Properties,
+		reporters.JUnitProperty{Name: "certsuite.skip_reason", Value: "no pods to check"})
+
+	// The report stays readable as a plain ginkgo JUnit report.
+	var plainReport reporters.JUnitTestSuites
+
+	assert.Nil(t, xml.Unmarshal(content, &plainReport))
+	assert.Equal(t, "[It] skipped test case", plainReport.TestSuites[0].TestCases[2].Name)
+}
+
+func TestCertsuiteJUnitProperties(t *testing.T) {
+	originalConf := conf
+
+	defer func() { conf = originalConf }()
+
+	conf = &config.Config{}
+	conf.General.Launcher = globalparameters.JobLauncherName
+	conf.General.CertsuiteImage = "quay.io/redhat-best-practices-for-k8s/certsuite"
+	conf.General.CertsuiteImageTag = "v5.5.0"
+	conf.General.CertsuiteVersionMatrix = []string{"v5.4.0", "latest"}
+
+	assert.Equal(t, map[string]string{
+		"certsuite.launcher":       globalparameters.JobLauncherName,
+		"certsuite.image":          "quay.io/redhat-best-practices-for-k8s/certsuite:v5.5.0",
+		"certsuite.version_matrix": "v5.4.0,latest",
+	}, certsuiteJUnitProperties())
+}
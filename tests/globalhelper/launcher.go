@@ -0,0 +1,116 @@
+package globalhelper
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+)
+
+// LaunchRequest describes a single certsuite invocation.
+type LaunchRequest struct {
+	// LabelFilter is the certsuite label expression, usually a single test case name.
+	LabelFilter string
+	// TcNameForReport is the spec name used to store debug logs and claim copies.
+	TcNameForReport string
+	// ReportDir is the directory where certsuite writes claim.json.
+	ReportDir string
+	// ConfigDir is the directory holding certsuite_config.yml.
+	ConfigDir string
+	// Policy sets the timeout and the number of attempts of the run.
+	Policy globalparameters.LaunchPolicy
+	// ImageTag, when set, overrides the configured certsuite image tag.
+	ImageTag string
+	// BinaryPath, when set, overrides the certsuite binary of the configured repo path.
+	BinaryPath string
+}
+
+// imageTag returns the certsuite image tag to run, failing when the request asks for a binary.
+func (r LaunchRequest) imageTag() (string, error) {
+	if r.BinaryPath != "" {
+		return "", fmt.Errorf("certsuite binary %s can only be run by the %s launcher", r.BinaryPath,
+			globalparameters.BinaryLauncherName)
+	}
+
+	if r.ImageTag != "" {
+		return r.ImageTag, nil
+	}
+
+	return GetConfiguration().General.CertsuiteImageTag, nil
+}
+
+// binaryPath returns the certsuite binary to run, failing when the request asks for an image tag.
+func (r LaunchRequest) binaryPath() (string, error) {
+	if r.ImageTag != "" {
+		return "", fmt.Errorf("certsuite image tag %s can not be run by the %s launcher", r.ImageTag,
+			globalparameters.BinaryLauncherName)
+	}
+
+	if r.BinaryPath != "" {
+		return r.BinaryPath, nil
+	}
+
+	if binaryPath := getCertsuiteBinary(); binaryPath != "" {
+		return binaryPath, nil
+	}
+
+	return fmt.Sprintf("%s/%s", GetConfiguration().General.CertsuiteRepoPath,
+		GetConfiguration().General.CertsuiteEntryPointBinary), nil
+}
+
+// launchPolicy returns the request policy with its unset fields defaulted.
+func (r LaunchRequest) launchPolicy() globalparameters.LaunchPolicy {
+	return withDefaultLaunchPolicy(r.Policy)
+}
+
+// Launcher runs certsuite for a LaunchRequest and leaves claim.json in the request's report directory.
+// The returned result is nil when certsuite could not be started.
+type Launcher interface {
+	Launch(request LaunchRequest) (*LaunchResult, error)
+}
+
+var (
+	launchersLock sync.RWMutex
+	launchers     = map[string]Launcher{
+		globalparameters.BinaryLauncherName:    &binaryLauncher{},
+		globalparameters.ContainerLauncherName: &containerLauncher{},
+		globalparameters.FakeLauncherName:      &FakeLauncher{},
+		globalparameters.JobLauncherName:       &jobLauncher{},
+	}
+)
+
+// RegisterLauncher registers a launcher under the given name, replacing any launcher
+// previously registered with the same name.
+func RegisterLauncher(name string, launcher Launcher) {
+	launchersLock.Lock()
+	defer launchersLock.Unlock()
+
+	launchers[name] = launcher
+}
+
+// GetLauncher returns the launcher registered under the given name.
+//
+//nolint:ireturn
+func GetLauncher(name string) (Launcher, error) {
+	launchersLock.RLock()
+	defer launchersLock.RUnlock()
+
+	launcher, found := launchers[name]
+	if !found {
+		return nil, fmt.Errorf("launcher %q is not registered, available launchers: %v", name, registeredLauncherNames())
+	}
+
+	return launcher, nil
+}
+
+func registeredLauncherNames() []string {
+	names := make([]string, 0, len(launchers))
+	for name := range launchers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,52 @@
+package globalhelper
+
+import (
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/stretchr/testify/assert"
+)
+
+type testLauncher struct {
+	launched int
+}
+
+func (l *testLauncher) Launch(_ LaunchRequest) (*LaunchResult, error) {
+	l.launched++
+
+	return &LaunchResult{Attempts: 1, Class: FailureClassCompleted}, nil
+}
+
+func TestGetLauncher(t *testing.T) {
+	for _, name := range []string{
+		globalparameters.BinaryLauncherName,
+		globalparameters.ContainerLauncherName,
+		globalparameters.FakeLauncherName,
+	} {
+		launcher, err := GetLauncher(name)
+		assert.Nil(t, err)
+		assert.NotNil(t, launcher)
+	}
+
+	launcher, err := GetLauncher("non-existing")
+	assert.NotNil(t, err)
+	assert.Nil(t, launcher)
+	assert.Contains(t, err.Error(), globalparameters.BinaryLauncherName)
+}
+
+func TestRegisterLauncher(t *testing.T) {
+	registeredLauncher := &testLauncher{}
+	RegisterLauncher("test", registeredLauncher)
+
+	defer func() {
+		launchersLock.Lock()
+		delete(launchers, "test")
+		launchersLock.Unlock()
+	}()
+
+	launcher, err := GetLauncher("test")
+	assert.Nil(t, err)
+	_, err = launcher.Launch(LaunchRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, registeredLauncher.launched)
+}
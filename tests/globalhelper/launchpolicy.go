@@ -0,0 +1,72 @@
+package globalhelper
+
+import (
+	"sync"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+)
+
+var (
+	launchPoliciesLock sync.RWMutex
+	launchPolicies     = map[string]globalparameters.LaunchPolicy{}
+)
+
+// RegisterLaunchPolicies registers the launch policies declared by a suite. Keys are certsuite
+// test case names, or suite names to set the policy of every test case of the suite.
+func RegisterLaunchPolicies(policies map[string]globalparameters.LaunchPolicy) {
+	launchPoliciesLock.Lock()
+	defer launchPoliciesLock.Unlock()
+
+	for name, policy := range policies {
+		launchPolicies[name] = policy
+	}
+}
+
+// GetLaunchPolicy returns the launch policy of a label filter. When the filter selects several
+// test cases, their timeouts are added up and the highest number of attempts is kept.
+func GetLaunchPolicy(labelFilter string) globalparameters.LaunchPolicy {
+	launchPoliciesLock.RLock()
+	defer launchPoliciesLock.RUnlock()
+
+	tcNames := splitLabelFilter(labelFilter)
+	if len(tcNames) == 0 {
+		return withDefaultLaunchPolicy(globalparameters.LaunchPolicy{})
+	}
+
+	policy := globalparameters.LaunchPolicy{}
+
+	for _, tcName := range tcNames {
+		tcPolicy := withDefaultLaunchPolicy(lookupLaunchPolicy(tcName))
+
+		policy.Timeout += tcPolicy.Timeout
+		policy.MaxAttempts = max(policy.MaxAttempts, tcPolicy.MaxAttempts)
+	}
+
+	return policy
+}
+
+func lookupLaunchPolicy(tcName string) globalparameters.LaunchPolicy {
+	if policy, found := launchPolicies[tcName]; found {
+		return policy
+	}
+
+	suiteName, err := getTestSuiteName(tcName)
+	if err != nil {
+		return globalparameters.LaunchPolicy{}
+	}
+
+	return launchPolicies[suiteName]
+}
+
+// withDefaultLaunchPolicy fills the unset fields of a policy with TestTimeout and MaxRetries.
+func withDefaultLaunchPolicy(policy globalparameters.LaunchPolicy) globalparameters.LaunchPolicy {
+	if policy.Timeout <= 0 {
+		policy.Timeout = TestTimeout
+	}
+
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = MaxRetries
+	}
+
+	return policy
+}
@@ -0,0 +1,38 @@
+package globalhelper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLaunchPolicy(t *testing.T) {
+	launchPoliciesLock.Lock()
+	originalPolicies := launchPolicies
+	launchPolicies = map[string]globalparameters.LaunchPolicy{}
+	launchPoliciesLock.Unlock()
+
+	defer func() {
+		launchPoliciesLock.Lock()
+		launchPolicies = originalPolicies
+		launchPoliciesLock.Unlock()
+	}()
+
+	RegisterLaunchPolicies(map[string]globalparameters.LaunchPolicy{
+		globalparameters.AccessControlSuiteName: {Timeout: 10 * time.Minute},
+		"access-control-crd-roles":              {Timeout: 20 * time.Minute, MaxAttempts: 1},
+		"lifecycle-crd-scaling":                 {Timeout: time.Hour, MaxAttempts: 3},
+	})
+
+	assert.Equal(t, globalparameters.LaunchPolicy{Timeout: TestTimeout, MaxAttempts: MaxRetries},
+		GetLaunchPolicy("observability-container-logging"))
+	assert.Equal(t, globalparameters.LaunchPolicy{Timeout: 10 * time.Minute, MaxAttempts: MaxRetries},
+		GetLaunchPolicy("access-control-pod-host-pid"))
+	assert.Equal(t, globalparameters.LaunchPolicy{Timeout: 20 * time.Minute, MaxAttempts: 1},
+		GetLaunchPolicy("access-control-crd-roles"))
+	assert.Equal(t, globalparameters.LaunchPolicy{Timeout: 90 * time.Minute, MaxAttempts: 3},
+		GetLaunchPolicy("lifecycle-crd-scaling || access-control-crd-roles || access-control-pod-host-pid"))
+	assert.Equal(t, globalparameters.LaunchPolicy{Timeout: TestTimeout, MaxAttempts: MaxRetries}, GetLaunchPolicy(""))
+}
@@ -0,0 +1,174 @@
+package globalhelper
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+)
+
+// launchOutputTailSize is the number of bytes of certsuite output kept in a LaunchResult.
+const launchOutputTailSize = 4096
+
+// FailureClass tells why a certsuite run ended.
+type FailureClass string
+
+const (
+	// FailureClassCompleted means certsuite ran to completion, whatever the test case results.
+	FailureClassCompleted FailureClass = "completed"
+	// FailureClassTimeout means certsuite did not finish before the launch timeout.
+	FailureClassTimeout FailureClass = "timeout"
+	// FailureClassInfra means certsuite could not run because of the environment: unreachable
+	// cluster, container engine or network errors.
+	FailureClassInfra FailureClass = "infra"
+	// FailureClassImagePull means the certsuite image could not be pulled.
+	FailureClassImagePull FailureClass = "image-pull"
+	// FailureClassPanic means certsuite crashed.
+	FailureClassPanic FailureClass = "panic"
+	// FailureClassAuth means certsuite could not authenticate against the cluster.
+	FailureClassAuth FailureClass = "auth"
+	// FailureClassUnknown means certsuite failed without producing a claim for an unknown reason.
+	FailureClassUnknown FailureClass = "unknown"
+)
+
+// Output patterns used to classify runs that failed without a claim, checked in order.
+var failureClassPatterns = []struct {
+	class    FailureClass
+	patterns []string
+}{
+	{FailureClassPanic, []string{"panic:", "goroutine 1 [", "fatal error:"}},
+	{FailureClassImagePull, []string{"ErrImagePull", "ImagePullBackOff", "pull access denied", "manifest unknown",
+		"Error: initializing source", "unable to pull", "failed to pull image"}},
+	{FailureClassAuth, []string{"Unauthorized", "You must be logged in to the server",
+		"no configuration has been provided", "certificate signed by unknown authority", "x509:"}},
+	{FailureClassInfra, []string{"connection refused", "no such host", "i/o timeout", "TLS handshake timeout",
+		"connection reset by peer", "no route to host", "executable file not found", "Cannot connect to the Docker daemon",
+		"Cannot connect to Podman", "server is currently unable to handle the request", "etcdserver: request timed out"}},
+}
+
+// LaunchResult describes the outcome of a certsuite run.
+type LaunchResult struct {
+	// ExitCode is the certsuite exit code, -1 when it could not be retrieved.
+	ExitCode int
+	// Duration is the total time spent running certsuite, retries included.
+	Duration time.Duration
+	// Attempts is the number of times certsuite was started.
+	Attempts int
+	// ClaimPath is the path of the claim written by certsuite, empty if there is none.
+	ClaimPath string
+	// OutputTail holds the last bytes written by certsuite on stdout and stderr.
+	OutputTail string
+	// Class tells why the run ended.
+	Class FailureClass
+	// Warnings and Errors hold the certsuite log lines logged with these levels.
+	Warnings []string
+	Errors   []string
+	// CheckResults holds the certsuite per test case summary, such as "PASS access-control-pod-host-pid".
+	CheckResults []string
+	// LogFindings holds the panics, goroutine dumps, probe pod messages and errors of the
+	// certsuite log.
+	LogFindings []LogFinding
+}
+
+// Retryable returns true when the run failed for a reason that may go away on a new attempt.
+func (r *LaunchResult) Retryable() bool {
+	return r.Class == FailureClassInfra || r.Class == FailureClassTimeout
+}
+
+func (r *LaunchResult) String() string {
+	return fmt.Sprintf("class: %s, exit code: %d, attempts: %d, duration: %v", r.Class, r.ExitCode, r.Attempts,
+		r.Duration.Round(time.Second))
+}
+
+// finish records the outcome of an attempt started at the given time.
+func (r *LaunchResult) finish(start time.Time, err error, timedOut bool, output string, reportDir string) {
+	r.Duration += time.Since(start)
+	r.ExitCode = exitCodeOf(err)
+	r.OutputTail = output
+	r.ClaimPath = ""
+
+	claimPath := path.Join(reportDir, globalparameters.DefaultClaimFileName)
+	if _, statErr := os.Stat(claimPath); statErr == nil {
+		r.ClaimPath = claimPath
+	}
+
+	r.Class = classifyFailure(err, timedOut, output, r.ClaimPath != "")
+}
+
+// classifyFailure classifies a run from its error and output.
+func classifyFailure(err error, timedOut bool, output string, claimFound bool) FailureClass {
+	if err == nil {
+		return FailureClassCompleted
+	}
+
+	if timedOut {
+		return FailureClassTimeout
+	}
+
+	// certsuite writes the claim once every test case has run and returns a non-zero exit
+	// code when some of them failed: the run is complete.
+	if claimFound {
+		return FailureClassCompleted
+	}
+
+	text := output + "\n" + err.Error()
+
+	for _, classPatterns := range failureClassPatterns {
+		for _, pattern := range classPatterns.patterns {
+			if strings.Contains(text, pattern) {
+				return classPatterns.class
+			}
+		}
+	}
+
+	return FailureClassUnknown
+}
+
+func exitCodeOf(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+
+	return -1
+}
+
+// tailWriter keeps the last bytes written to it.
+type tailWriter struct {
+	lock  sync.Mutex
+	limit int
+	buf   []byte
+}
+
+func newTailWriter(limit int) *tailWriter {
+	return &tailWriter{limit: limit}
+}
+
+func (w *tailWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.buf = append(w.buf, p...)
+	if len(w.buf) > w.limit {
+		w.buf = append([]byte{}, w.buf[len(w.buf)-w.limit:]...)
+	}
+
+	return len(p), nil
+}
+
+func (w *tailWriter) String() string {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return string(w.buf)
+}
@@ -0,0 +1,122 @@
+package globalhelper
+
+import (
+	"errors"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyFailure(t *testing.T) {
+	errExit := errors.New("exit status 1")
+
+	testCases := []struct {
+		err        error
+		timedOut   bool
+		output     string
+		claimFound bool
+		expected   FailureClass
+	}{
+		{nil, false, "", true, FailureClassCompleted},
+		{errExit, true, "", false, FailureClassTimeout},
+		{errExit, false, "connection refused", true, FailureClassCompleted},
+		{errExit, false, "panic: runtime error: invalid memory address", false, FailureClassPanic},
+		{errExit, false, "Error: initializing source docker://quay.io/certsuite:v0", false, FailureClassImagePull},
+		{errExit, false, "error: You must be logged in to the server (Unauthorized)", false, FailureClassAuth},
+		{errExit, false, "dial tcp 10.0.0.1:6443: connect: connection refused", false, FailureClassInfra},
+		{errors.New("exec: \"podman\": executable file not found in $PATH"), false, "", false, FailureClassInfra},
+		{errExit, false, "something else", false, FailureClassUnknown},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected,
+			classifyFailure(testCase.err, testCase.timedOut, testCase.output, testCase.claimFound), testCase.output)
+	}
+}
+
+func TestLaunchResultRetryable(t *testing.T) {
+	assert.True(t, (&LaunchResult{Class: FailureClassInfra}).Retryable())
+	assert.True(t, (&LaunchResult{Class: FailureClassTimeout}).Retryable())
+	assert.False(t, (&LaunchResult{Class: FailureClassAuth}).Retryable())
+	assert.False(t, (&LaunchResult{Class: FailureClassCompleted}).Retryable())
+}
+
+func TestTailWriter(t *testing.T) {
+	tail := newTailWriter(5)
+
+	written, err := tail.Write([]byte("abc"))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, written)
+
+	_, err = tail.Write([]byte("defgh"))
+	assert.Nil(t, err)
+	assert.Equal(t, "defgh", tail.String())
+}
+
+func TestExecuteWithRetry(t *testing.T) {
+	reportDir := t.TempDir()
+
+	request := LaunchRequest{LabelFilter: "access-control-pod-host-pid", ReportDir: reportDir}
+
+	result, err := executeWithRetry(launchCommand{
+		path: "sh",
+		args: []string{"-c", "echo dial tcp: connection refused; exit 1"},
+	}, request)
+	assert.NotNil(t, err)
+	assert.Equal(t, MaxRetries, result.Attempts)
+	assert.Equal(t, 1, result.ExitCode)
+	assert.Equal(t, FailureClassInfra, result.Class)
+	assert.Equal(t, "dial tcp: connection refused\n", result.OutputTail)
+
+	var output strings.Builder
+
+	result, err = executeWithRetry(launchCommand{
+		path:   "sh",
+		args:   []string{"-c", "echo panic: boom >&2; exit 2"},
+		output: &output,
+	}, request)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, result.Attempts)
+	assert.Equal(t, 2, result.ExitCode)
+	assert.Equal(t, FailureClassPanic, result.Class)
+	assert.Equal(t, "panic: boom\n", output.String())
+
+	assert.Nil(t, os.WriteFile(path.Join(reportDir, globalparameters.DefaultClaimFileName), []byte("{}"), 0600))
+
+	result, err = executeWithRetry(launchCommand{
+		path: "sh",
+		args: []string{"-c", "echo WARN no operators found; echo [ PASS ] access-control-pod-host-pid"},
+	}, request)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result.Attempts)
+	assert.Equal(t, 0, result.ExitCode)
+	assert.Equal(t, FailureClassCompleted, result.Class)
+	assert.Equal(t, path.Join(reportDir, globalparameters.DefaultClaimFileName), result.ClaimPath)
+	assert.Equal(t, []string{"WARN no operators found"}, result.Warnings)
+	assert.Equal(t, []string{"PASS access-control-pod-host-pid"}, result.CheckResults)
+}
+
+func TestExecuteWithRetryTimeout(t *testing.T) {
+	cleanups := 0
+
+	request := LaunchRequest{
+		LabelFilter: "lifecycle-crd-scaling",
+		ReportDir:   t.TempDir(),
+		Policy:      globalparameters.LaunchPolicy{Timeout: 100 * time.Millisecond, MaxAttempts: 3},
+	}
+
+	result, err := executeWithRetry(launchCommand{
+		path:    "sleep",
+		args:    []string{"10"},
+		cleanup: func() { cleanups++ },
+	}, request)
+	assert.NotNil(t, err)
+	assert.Equal(t, FailureClassTimeout, result.Class)
+	assert.Equal(t, 3, result.Attempts)
+	assert.Equal(t, 3, cleanups)
+}
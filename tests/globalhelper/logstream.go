@@ -0,0 +1,145 @@
+package globalhelper
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// maxLogEntries is the number of warnings, errors or check results kept for a certsuite run.
+const maxLogEntries = 50
+
+var (
+	ansiEscapeRegex = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+	// certsuite log lines start with their level, e.g. "WARN  [Oct 17 10:00:00.000] [file.go: 10] msg".
+	logLevelRegex = regexp.MustCompile(`^\[?(DEBUG|INFO|WARN|WARNING|ERROR|FATAL)\]?\s`)
+	// certsuite prints one line per check once it has run, e.g. "[ PASS ] access-control-pod-host-pid".
+	checkResultRegex = regexp.MustCompile(`^\[\s*(PASS|FAIL|SKIP|ERROR|ABORT)\s*\]\s+(\S+)`)
+)
+
+// certsuiteLogWriter streams the certsuite output line by line to a writer, usually GinkgoWriter,
+// and keeps the warnings, errors and check results found in it.
+type certsuiteLogWriter struct {
+	lock         sync.Mutex
+	out          io.Writer
+	partial      []byte
+	warnings     []string
+	errors       []string
+	checkResults []string
+}
+
+func newCertsuiteLogWriter(out io.Writer) *certsuiteLogWriter {
+	return &certsuiteLogWriter{out: out}
+}
+
+func (w *certsuiteLogWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.partial = append(w.partial, p...)
+
+	for {
+		index := bytes.IndexByte(w.partial, '\n')
+		if index < 0 {
+			break
+		}
+
+		w.processLine(string(w.partial[:index]))
+		w.partial = w.partial[index+1:]
+	}
+
+	return len(p), nil
+}
+
+// Flush processes the last line when the output does not end with a new line.
+func (w *certsuiteLogWriter) Flush() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if len(w.partial) > 0 {
+		w.processLine(string(w.partial))
+		w.partial = nil
+	}
+}
+
+// record copies the warnings, errors and check results found so far into the result.
+func (w *certsuiteLogWriter) record(result *LaunchResult) {
+	w.Flush()
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	result.Warnings = append([]string{}, w.warnings...)
+	result.Errors = append([]string{}, w.errors...)
+	result.CheckResults = append([]string{}, w.checkResults...)
+}
+
+func (w *certsuiteLogWriter) processLine(line string) {
+	// certsuite redraws its progress lines with carriage returns, keep the last one only.
+	if index := strings.LastIndexByte(line, '\r'); index >= 0 {
+		line = line[index+1:]
+	}
+
+	line = strings.TrimRight(ansiEscapeRegex.ReplaceAllString(line, ""), " \t")
+	if line == "" {
+		return
+	}
+
+	if w.out != nil {
+		_, _ = fmt.Fprintln(w.out, line)
+	}
+
+	if match := checkResultRegex.FindStringSubmatch(line); match != nil {
+		w.checkResults = appendLogEntry(w.checkResults, match[1]+" "+match[2])
+
+		return
+	}
+
+	match := logLevelRegex.FindStringSubmatch(line)
+	if match == nil {
+		return
+	}
+
+	switch match[1] {
+	case "WARN", "WARNING":
+		w.warnings = appendLogEntry(w.warnings, line)
+	case "ERROR", "FATAL":
+		w.errors = appendLogEntry(w.errors, line)
+	}
+}
+
+func appendLogEntry(entries []string, entry string) []string {
+	if len(entries) >= maxLogEntries {
+		return entries
+	}
+
+	return append(entries, entry)
+}
+
+// reportLaunchResult attaches the outcome of a certsuite run to the current spec report, so that
+// it shows in the JUnit XML. It does nothing outside a running spec.
+func reportLaunchResult(result *LaunchResult) {
+	if result == nil || CurrentSpecReport().LeafNodeType == types.NodeTypeInvalid {
+		return
+	}
+
+	AddReportEntry("certsuite run", result.String())
+
+	if len(result.CheckResults) > 0 {
+		AddReportEntry("certsuite results", strings.Join(result.CheckResults, "\n"))
+	}
+
+	if len(result.Warnings) > 0 {
+		AddReportEntry("certsuite warnings", strings.Join(result.Warnings, "\n"))
+	}
+
+	if len(result.Errors) > 0 {
+		AddReportEntry("certsuite errors", strings.Join(result.Errors, "\n"))
+	}
+}
@@ -0,0 +1,51 @@
+package globalhelper
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertsuiteLogWriter(t *testing.T) {
+	var out strings.Builder
+
+	logs := newCertsuiteLogWriter(&out)
+
+	_, err := logs.Write([]byte("INFO  [Oct 17 10:00:00.000] [certsuite.go: 84] Certsuite Version: v5\nWARN  [Oct 17"))
+	assert.Nil(t, err)
+
+	_, err = logs.Write([]byte(" 10:00:01.000] [autodiscover.go: 12] no operators found\n" +
+		"ERROR [Oct 17 10:00:02.000] [checksdb.go: 20] check failed\n" +
+		"\r[ \x1b[33mRUNNING\x1b[0m ] access-control-pod-host-pid\r[ \x1b[32mPASS\x1b[0m ] access-control-pod-host-pid\n" +
+		"[ SKIP ] access-control-pod-host-ipc"))
+	assert.Nil(t, err)
+
+	result := &LaunchResult{}
+	logs.record(result)
+
+	assert.Equal(t, []string{"WARN  [Oct 17 10:00:01.000] [autodiscover.go: 12] no operators found"}, result.Warnings)
+	assert.Equal(t, []string{"ERROR [Oct 17 10:00:02.000] [checksdb.go: 20] check failed"}, result.Errors)
+	assert.Equal(t, []string{"PASS access-control-pod-host-pid", "SKIP access-control-pod-host-ipc"}, result.CheckResults)
+	assert.Equal(t, 5, strings.Count(out.String(), "\n"))
+	assert.Contains(t, out.String(), "[ PASS ] access-control-pod-host-pid\n")
+}
+
+func TestCertsuiteLogWriterLimit(t *testing.T) {
+	logs := newCertsuiteLogWriter(nil)
+
+	for i := range maxLogEntries + 10 {
+		_, err := fmt.Fprintf(logs, "ERROR error %d\n", i)
+		assert.Nil(t, err)
+	}
+
+	result := &LaunchResult{}
+	logs.record(result)
+	assert.Len(t, result.Errors, maxLogEntries)
+}
+
+func TestReportLaunchResultOutsideSpec(t *testing.T) {
+	assert.NotPanics(t, func() { reportLaunchResult(&LaunchResult{Errors: []string{"ERROR error"}}) })
+	assert.NotPanics(t, func() { reportLaunchResult(nil) })
+}
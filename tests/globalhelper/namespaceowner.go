@@ -0,0 +1,112 @@
+package globalhelper
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Labels set on the namespaces created by CreateNamespace and CreatePrivilegedNamespace, to find
+// the namespaces left by a run.
+const (
+	namespaceLabelPrefix = "certsuite-qe.test-network-function.com/"
+	// NamespaceOwnerLabel marks the namespaces created by certsuite-qe, with NamespaceOwner as value.
+	NamespaceOwnerLabel = namespaceLabelPrefix + "owner"
+	// NamespaceRunIDLabel is the run that created the namespace: CERTSUITE_QE_RUN_ID, set once for
+	// all the suites by scripts/run-tests.sh, else the CI job ID, else "local-<ginkgo random seed>".
+	NamespaceRunIDLabel = namespaceLabelPrefix + "run-id"
+	// NamespaceSuiteLabel is the suite that created the namespace.
+	NamespaceSuiteLabel = namespaceLabelPrefix + "suite"
+	// NamespaceSpecLabel is the spec that created the namespace, truncated to a label value.
+	NamespaceSpecLabel = namespaceLabelPrefix + "spec"
+	// NamespaceCreatedAtLabel is the unix time the namespace was created at.
+	NamespaceCreatedAtLabel = namespaceLabelPrefix + "created-at"
+
+	NamespaceOwner = "certsuite-qe"
+
+	maxLabelValueLength = 63
+)
+
+var (
+	invalidLabelValueChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+	// currentSuiteName is the suite run by RunSuite in this process.
+	currentSuiteName string
+
+	runID     string
+	runIDOnce sync.Once
+)
+
+// GetRunID returns the ID of the run, set in the NamespaceRunIDLabel of the namespaces it creates.
+func GetRunID() string {
+	runIDOnce.Do(func() {
+		runID = defaultRunID(os.Getenv("CERTSUITE_QE_RUN_ID"), os.Getenv("JOB_ID"), GinkgoRandomSeed())
+	})
+
+	return runID
+}
+
+func defaultRunID(qeRunID, jobID string, randomSeed int64) string {
+	if qeRunID != "" {
+		return sanitizeLabelValue(qeRunID)
+	}
+
+	if jobID != "" {
+		return sanitizeLabelValue(jobID)
+	}
+
+	return fmt.Sprintf("local-%d", randomSeed)
+}
+
+// namespaceOwnershipLabels returns the labels of a namespace created now by the running spec.
+func namespaceOwnershipLabels(now time.Time) map[string]string {
+	labels := map[string]string{
+		NamespaceOwnerLabel:     NamespaceOwner,
+		NamespaceRunIDLabel:     GetRunID(),
+		NamespaceCreatedAtLabel: strconv.FormatInt(now.Unix(), 10),
+	}
+
+	if suite := sanitizeLabelValue(currentSuiteName); suite != "" {
+		labels[NamespaceSuiteLabel] = suite
+	}
+
+	if spec := sanitizeLabelValue(CurrentSpecReport().LeafNodeText); spec != "" {
+		labels[NamespaceSpecLabel] = spec
+	}
+
+	return labels
+}
+
+// sanitizeLabelValue turns a text into a valid label value: the invalid characters are replaced
+// by "-" and it is truncated to 63 characters, starting and ending with an alphanumeric one.
+func sanitizeLabelValue(text string) string {
+	value := invalidLabelValueChars.ReplaceAllString(text, "-")
+	if len(value) > maxLabelValueLength {
+		value = value[:maxLabelValueLength]
+	}
+
+	return strings.Trim(value, "._-")
+}
+
+// IsQENamespace returns true when the namespace was created by certsuite-qe.
+func IsQENamespace(namespace *corev1.Namespace) bool {
+	return namespace.Labels[NamespaceOwnerLabel] == NamespaceOwner
+}
+
+// namespaceCreatedAt returns the creation time of a namespace from its NamespaceCreatedAtLabel,
+// or from its metadata when the label is missing or invalid.
+func namespaceCreatedAt(namespace *corev1.Namespace) time.Time {
+	createdAt, err := strconv.ParseInt(namespace.Labels[NamespaceCreatedAtLabel], 10, 64)
+	if err != nil {
+		return namespace.CreationTimestamp.Time
+	}
+
+	return time.Unix(createdAt, 0)
+}
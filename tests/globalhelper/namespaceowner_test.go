@@ -0,0 +1,70 @@
+package globalhelper
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	egiClients "github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSanitizeLabelValue(t *testing.T) {
+	testCases := []struct {
+		text     string
+		expected string
+	}{
+		{"CNFCert access-control tests", "CNFCert-access-control-tests"},
+		{"Two pods with hostPID, one of them disabled", "Two-pods-with-hostPID-one-of-them-disabled"},
+		{"-trimmed-", "trimmed"},
+		{"", ""},
+		{strings.Repeat("a", 70), strings.Repeat("a", 63)},
+		{strings.Repeat("a", 62) + " b", strings.Repeat("a", 62)},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, sanitizeLabelValue(testCase.text), testCase.text)
+	}
+}
+
+func TestDefaultRunID(t *testing.T) {
+	assert.Equal(t, "local-20260101-42", defaultRunID("local-20260101-42", "1234", 42))
+	assert.Equal(t, "1234", defaultRunID("", "1234", 42))
+	assert.Equal(t, "local-42", defaultRunID("", "", 42))
+}
+
+func TestNamespaceCreatedAt(t *testing.T) {
+	createdAt := time.Unix(1700000000, 0)
+
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Labels:            map[string]string{NamespaceCreatedAtLabel: "1700000000"},
+		CreationTimestamp: metav1.NewTime(createdAt.Add(time.Hour)),
+	}}
+	assert.Equal(t, createdAt, namespaceCreatedAt(namespace))
+
+	namespace.Labels[NamespaceCreatedAtLabel] = "invalid"
+	assert.Equal(t, createdAt.Add(time.Hour), namespaceCreatedAt(namespace))
+}
+
+func TestCreateNamespaceOwnershipLabels(t *testing.T) {
+	originalSuiteName := currentSuiteName
+
+	defer func() { currentSuiteName = originalSuiteName }()
+
+	currentSuiteName = "CNFCert networking tests"
+
+	fakeClient := egiClients.GetTestClients(egiClients.TestClientParams{})
+
+	assert.Nil(t, createPrivilegedNamespace("test-namespace", fakeClient))
+
+	namespace, err := fakeClient.K8sClient.CoreV1().Namespaces().Get(context.TODO(), "test-namespace", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.True(t, IsQENamespace(namespace))
+	assert.Equal(t, GetRunID(), namespace.Labels[NamespaceRunIDLabel])
+	assert.Equal(t, "CNFCert-networking-tests", namespace.Labels[NamespaceSuiteLabel])
+	assert.Equal(t, "privileged", namespace.Labels["pod-security.kubernetes.io/enforce"])
+	assert.WithinDuration(t, time.Now(), namespaceCreatedAt(namespace), time.Minute)
+}
@@ -54,10 +54,10 @@ func CreatePrivilegedNamespace(namespace string) error {
 	return createPrivilegedNamespace(namespace, GetEcoGoinfraClient())
 }
 
-// Create creates a new namespace with the given name.
+// Create creates a new namespace with the given name, labeled with the run, suite and spec creating it.
 // If the namespace exists, it returns.
 func createNamespace(namespace string, client *egiClients.Settings) error {
-	_, err := egiNamespaces.NewBuilder(client, namespace).Create()
+	_, err := egiNamespaces.NewBuilder(client, namespace).WithMultipleLabels(namespaceOwnershipLabels(time.Now())).Create()
 
 	return err
 }
@@ -71,7 +71,7 @@ func createPrivilegedNamespace(namespace string, client *egiClients.Settings) er
 		"pod-security.kubernetes.io/warn":    "privileged",
 	}
 
-	nsBuilder := egiNamespaces.NewBuilder(client, namespace)
+	nsBuilder := egiNamespaces.NewBuilder(client, namespace).WithMultipleLabels(namespaceOwnershipLabels(time.Now()))
 
 	// Add Pod Security Standard labels
 	for key, value := range labels {
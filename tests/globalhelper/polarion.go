@@ -0,0 +1,216 @@
+package globalhelper
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/reporters"
+)
+
+const (
+	// PolarionIDLabelKey is the key of the label holding the Polarion test case ID of a spec.
+	PolarionIDLabelKey = "polarion"
+
+	polarionIDJUnitProperty = "polarion.testcase_id"
+)
+
+// PolarionID returns the label decorating a spec with its Polarion test case ID, so that specs can
+// be selected with --label-filter='polarion: {53140}' and exported to Polarion.
+func PolarionID(id string) Labels {
+	return Label(PolarionIDLabelKey + ":" + id)
+}
+
+// GetPolarionID returns the Polarion test case ID found in the labels of a spec, empty if none.
+func GetPolarionID(labels []string) string {
+	for _, label := range labels {
+		key, value, found := strings.Cut(label, ":")
+		if found && strings.TrimSpace(key) == PolarionIDLabelKey {
+			return strings.TrimSpace(value)
+		}
+	}
+
+	return ""
+}
+
+// PolarionExportOptions are the Polarion test run properties of an XUnit import file.
+type PolarionExportOptions struct {
+	ProjectID    string
+	TestRunID    string
+	TestRunTitle string
+	// IDPrefix is prepended to the spec Polarion IDs, e.g. "OCP-".
+	IDPrefix string
+}
+
+type polarionTestSuites struct {
+	XMLName    xml.Name                  `xml:"testsuites"`
+	Properties reporters.JUnitProperties `xml:"properties"`
+	TestSuites []polarionTestSuite       `xml:"testsuite"`
+}
+
+type polarionTestSuite struct {
+	Name      string             `xml:"name,attr"`
+	Tests     int                `xml:"tests,attr"`
+	Failures  int                `xml:"failures,attr"`
+	Errors    int                `xml:"errors,attr"`
+	Skipped   int                `xml:"skipped,attr"`
+	TestCases []polarionTestCase `xml:"testcase"`
+}
+
+type polarionTestCase struct {
+	Name       string                    `xml:"name,attr"`
+	Time       float64                   `xml:"time,attr"`
+	Properties reporters.JUnitProperties `xml:"properties"`
+	Skipped    *reporters.JUnitSkipped   `xml:"skipped,omitempty"`
+	Error      *reporters.JUnitError     `xml:"error,omitempty"`
+	Failure    *reporters.JUnitFailure   `xml:"failure,omitempty"`
+}
+
+// severity orders the results of the specs sharing a Polarion ID: the worst one is exported.
+func (c *polarionTestCase) severity() int {
+	switch {
+	case c.Error != nil:
+		return 3
+	case c.Failure != nil:
+		return 2
+	case c.Skipped == nil:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ExportPolarionXUnit writes a Polarion XUnit import file of the specs with a Polarion ID found in
+// the JUnit XML reports of reportDir, and returns the number of Polarion test cases written. Specs
+// sharing a Polarion ID are exported as a single test case with the worst of their results.
+func ExportPolarionXUnit(reportDir string, options PolarionExportOptions, writer io.Writer) (int, error) {
+	if options.ProjectID == "" {
+		return 0, errors.New("a polarion project ID is required")
+	}
+
+	junitReports, err := readJUnitReports(reportDir)
+	if err != nil {
+		return 0, err
+	}
+
+	xunit := polarionTestSuites{Properties: polarionTestRunProperties(options)}
+	exported := 0
+
+	for _, junitReport := range junitReports {
+		for _, suite := range junitReport.TestSuites {
+			testCases := polarionTestCases(suite, options.IDPrefix)
+			if len(testCases) == 0 {
+				continue
+			}
+
+			xunitSuite := polarionTestSuite{Name: suite.Name, Tests: len(testCases), TestCases: testCases}
+
+			for _, testCase := range testCases {
+				switch {
+				case testCase.Error != nil:
+					xunitSuite.Errors++
+				case testCase.Failure != nil:
+					xunitSuite.Failures++
+				case testCase.Skipped != nil:
+					xunitSuite.Skipped++
+				}
+			}
+
+			xunit.TestSuites = append(xunit.TestSuites, xunitSuite)
+			exported += len(testCases)
+		}
+	}
+
+	_, err = io.WriteString(writer, xml.Header)
+	if err != nil {
+		return 0, fmt.Errorf("failed to write polarion xunit: %w", err)
+	}
+
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+
+	err = encoder.Encode(xunit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to write polarion xunit: %w", err)
+	}
+
+	return exported, nil
+}
+
+func polarionTestRunProperties(options PolarionExportOptions) reporters.JUnitProperties {
+	properties := reporters.JUnitProperties{Properties: []reporters.JUnitProperty{
+		{Name: "polarion-project-id", Value: options.ProjectID},
+		{Name: "polarion-lookup-method", Value: "id"},
+	}}
+
+	if options.TestRunID != "" {
+		properties.Properties = append(properties.Properties,
+			reporters.JUnitProperty{Name: "polarion-testrun-id", Value: options.TestRunID})
+	}
+
+	if options.TestRunTitle != "" {
+		properties.Properties = append(properties.Properties,
+			reporters.JUnitProperty{Name: "polarion-testrun-title", Value: options.TestRunTitle})
+	}
+
+	return properties
+}
+
+// polarionTestCases returns the test cases of a JUnit suite specs with a Polarion ID, one per ID.
+func polarionTestCases(suite junitTestSuite, idPrefix string) []polarionTestCase {
+	testCasesByID := map[string]*polarionTestCase{}
+
+	for _, junitCase := range suite.TestCases {
+		if junitCase.Properties == nil {
+			continue
+		}
+
+		polarionID := junitCase.Properties.WithName(polarionIDJUnitProperty)
+		if polarionID == "" {
+			continue
+		}
+
+		testCase := polarionTestCase{
+			Name: strings.TrimPrefix(junitCase.Name, junitItPrefix),
+			Time: junitCase.Time,
+			Properties: reporters.JUnitProperties{Properties: []reporters.JUnitProperty{
+				{Name: "polarion-testcase-id", Value: idPrefix + polarionID},
+			}},
+			Skipped: junitCase.Skipped,
+			Error:   junitCase.Error,
+			Failure: junitCase.Failure,
+		}
+
+		previous, found := testCasesByID[polarionID]
+		if !found {
+			testCasesByID[polarionID] = &testCase
+
+			continue
+		}
+
+		if testCase.severity() > previous.severity() {
+			testCase.Name, testCase.Time = previous.Name+"; "+testCase.Name, previous.Time+testCase.Time
+			*previous = testCase
+		} else {
+			previous.Name, previous.Time = previous.Name+"; "+testCase.Name, previous.Time+testCase.Time
+		}
+	}
+
+	ids := make([]string, 0, len(testCasesByID))
+	for id := range testCasesByID {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	testCases := make([]polarionTestCase, 0, len(ids))
+	for _, id := range ids {
+		testCases = append(testCases, *testCasesByID[id])
+	}
+
+	return testCases
+}
@@ -0,0 +1,92 @@
+package globalhelper
+
+import (
+	"encoding/xml"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/onsi/ginkgo/v2/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPolarionID(t *testing.T) {
+	assert.Equal(t, "53140", GetPolarionID([]string{"accesscontrol9", "polarion:53140"}))
+	assert.Equal(t, "53140", GetPolarionID([]string{"polarion: 53140"}))
+	assert.Empty(t, GetPolarionID([]string{"accesscontrol9", "other:53140"}))
+	assert.Empty(t, GetPolarionID(nil))
+}
+
+func TestExportPolarionXUnit(t *testing.T) {
+	reportDir := t.TempDir()
+	report := types.Report{
+		SuiteDescription: "CNFCert access-control tests",
+		SpecReports: types.SpecReports{
+			{
+				LeafNodeType:   types.NodeTypeIt,
+				LeafNodeText:   "HostPid false",
+				LeafNodeLabels: []string{"accesscontrol9", "polarion:53140"},
+				State:          types.SpecStatePassed,
+			},
+			{
+				LeafNodeType:   types.NodeTypeIt,
+				LeafNodeText:   "HostPid true [negative]",
+				LeafNodeLabels: []string{"polarion:53141"},
+				State:          types.SpecStateFailed,
+				Failure:        types.Failure{Message: "unexpected state"},
+			},
+			{
+				LeafNodeType:   types.NodeTypeIt,
+				LeafNodeText:   "HostPids false",
+				LeafNodeLabels: []string{"polarion:53140"},
+				State:          types.SpecStateFailed,
+				Failure:        types.Failure{Message: "unexpected state"},
+			},
+			{
+				LeafNodeType:   types.NodeTypeIt,
+				LeafNodeText:   "skipped",
+				LeafNodeLabels: []string{"polarion:53142"},
+				State:          types.SpecStateSkipped,
+			},
+			{
+				LeafNodeType: types.NodeTypeIt,
+				LeafNodeText: "no polarion ID",
+				State:        types.SpecStatePassed,
+			},
+		},
+	}
+
+	assert.Nil(t, writeJUnitReport(report, filepath.Join(reportDir, "access_control_suite_test.xml"), nil))
+
+	var xunit strings.Builder
+
+	exported, err := ExportPolarionXUnit(reportDir, PolarionExportOptions{
+		ProjectID: "CERTSUITE", TestRunID: "run-1", IDPrefix: "OCP-",
+	}, &xunit)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, exported)
+
+	var exportedSuites polarionTestSuites
+
+	assert.Nil(t, xml.Unmarshal([]byte(xunit.String()), &exportedSuites))
+	assert.Equal(t, "CERTSUITE", exportedSuites.Properties.WithName("polarion-project-id"))
+	assert.Equal(t, "run-1", exportedSuites.Properties.WithName("polarion-testrun-id"))
+	assert.Len(t, exportedSuites.TestSuites, 1)
+
+	suite := exportedSuites.TestSuites[0]
+	assert.Equal(t, 3, suite.Tests)
+	assert.Equal(t, 2, suite.Failures)
+	assert.Equal(t, 1, suite.Skipped)
+
+	// The specs sharing 53140 are exported as one failed test case.
+	testCase := suite.TestCases[0]
+	assert.Equal(t, "OCP-53140", testCase.Properties.WithName("polarion-testcase-id"))
+	assert.Equal(t, "HostPid false [accesscontrol9, polarion:53140]; HostPids false [polarion:53140]", testCase.Name)
+	assert.NotNil(t, testCase.Failure)
+
+	assert.Equal(t, "OCP-53142", suite.TestCases[2].Properties.WithName("polarion-testcase-id"))
+	assert.NotNil(t, suite.TestCases[2].Skipped)
+
+	_, err = ExportPolarionXUnit(reportDir, PolarionExportOptions{}, &xunit)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,90 @@
+package globalhelper
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klog "k8s.io/klog/v2"
+)
+
+// openShiftConfigAPIGroup is served by OpenShift clusters only.
+const openShiftConfigAPIGroup = "config.openshift.io"
+
+// GetClusterProfile returns the name of the active environment profile, empty when none is
+// configured.
+func GetClusterProfile() string {
+	return GetConfiguration().General.Profile
+}
+
+// configuredProfile returns the active profile and true when it is known without probing the
+// cluster: set in the configuration or already detected.
+func configuredProfile() (string, bool) {
+	profile := GetClusterProfile()
+
+	return profile, profile != "" && profile != config.ProfileAuto
+}
+
+// resolveAutoProfile detects the profile of the cluster and applies it. Without a kubeconfig the
+// general configuration is kept.
+func resolveAutoProfile(cfg *config.Config) {
+	if os.Getenv("KUBECONFIG") == "" {
+		klog.Warning("KUBECONFIG is not set, not detecting the environment profile")
+
+		cfg.General.Profile = ""
+
+		return
+	}
+
+	profile, err := DetectClusterProfile()
+	if err == nil {
+		err = cfg.ApplyProfile(profile)
+	}
+
+	if err != nil {
+		klog.ErrorS(err, "failed to apply the detected environment profile, keeping the general configuration")
+
+		cfg.General.Profile = ""
+	}
+}
+
+// DetectClusterProfile returns the built-in profile matching the cluster.
+func DetectClusterProfile() (string, error) {
+	nodes, err := GetAPIClient().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	return detectProfile(IsKindCluster(), IsCRCCluster(), isOpenShiftCluster(), len(nodes.Items)), nil
+}
+
+func detectProfile(isKind, isCRC, isOpenShift bool, nodeCount int) string {
+	switch {
+	case isKind:
+		return config.ProfileKind
+	case isCRC:
+		return config.ProfileCRC
+	case !isOpenShift:
+		return config.ProfileK8s
+	case nodeCount == 1:
+		return config.ProfileOCPSNO
+	default:
+		return config.ProfileOCP
+	}
+}
+
+func isOpenShiftCluster() bool {
+	groups, err := GetAPIClient().ServerGroups()
+	if err != nil {
+		klog.ErrorS(err, "failed to list the API groups of the cluster")
+
+		return false
+	}
+
+	return slices.ContainsFunc(groups.Groups, func(group metav1.APIGroup) bool {
+		return group.Name == openShiftConfigAPIGroup
+	})
+}
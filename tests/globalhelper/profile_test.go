@@ -0,0 +1,55 @@
+package globalhelper
+
+import (
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectProfile(t *testing.T) {
+	testCases := []struct {
+		isKind, isCRC, isOpenShift bool
+		nodeCount                  int
+		expected                   string
+	}{
+		{true, false, false, 3, config.ProfileKind},
+		{false, true, true, 1, config.ProfileCRC},
+		{false, false, false, 1, config.ProfileK8s},
+		{false, false, true, 1, config.ProfileOCPSNO},
+		{false, false, true, 5, config.ProfileOCP},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected,
+			detectProfile(testCase.isKind, testCase.isCRC, testCase.isOpenShift, testCase.nodeCount))
+	}
+}
+
+func TestResolveAutoProfileWithoutKubeconfig(t *testing.T) {
+	t.Setenv("KUBECONFIG", "")
+
+	cfg := &config.Config{}
+	cfg.General.Profile = config.ProfileAuto
+
+	resolveAutoProfile(cfg)
+	assert.Equal(t, "", cfg.General.Profile)
+}
+
+func TestConfiguredProfile(t *testing.T) {
+	originalConf := conf
+
+	defer func() { conf = originalConf }()
+
+	conf = &config.Config{}
+	conf.General.Profile = config.ProfileKind
+
+	assert.True(t, IsKindCluster())
+	assert.False(t, IsCRCCluster())
+
+	conf.General.Profile = config.ProfileCRC
+
+	assert.False(t, IsKindCluster())
+	assert.True(t, IsCRCCluster())
+	assert.Equal(t, config.ProfileCRC, GetClusterProfile())
+}
@@ -0,0 +1,138 @@
+package globalhelper
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-claim/pkg/claim"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+)
+
+// Skip reasons of certsuite checks finding nothing to check, as written by the GetNo*SkipFn
+// helpers of certsuite's testhelper package. certsuite joins the reasons of a check with several
+// skip functions, so they are matched as substrings.
+var (
+	SkipReasonNoContainers = ReasonContains("no containers to check found")
+	SkipReasonNoPods       = ReasonContains("no pods to check found")
+	SkipReasonNoOperators  = ReasonContains("no operators found")
+	// certsuite words the missing CRDs skip reason like the missing roles one.
+	SkipReasonNoCrds                       = ReasonContains("no roles to check")
+	SkipReasonNoSharedProcessNamespacePods = ReasonContains("Shared process namespace pods found.")
+)
+
+// ReasonMatcher matches the skip reason or a failure reason of a certsuite test case.
+type ReasonMatcher struct {
+	description string
+	match       func(reason string) bool
+	err         error
+}
+
+// ReasonEquals matches a reason equal to the given one.
+func ReasonEquals(reason string) ReasonMatcher {
+	return ReasonMatcher{
+		description: fmt.Sprintf("equal to %q", reason),
+		match:       func(actual string) bool { return actual == reason },
+	}
+}
+
+// ReasonContains matches a reason containing the given text.
+func ReasonContains(substr string) ReasonMatcher {
+	return ReasonMatcher{
+		description: fmt.Sprintf("containing %q", substr),
+		match:       func(actual string) bool { return strings.Contains(actual, substr) },
+	}
+}
+
+// ReasonMatches matches a reason matching the given regular expression. An invalid expression
+// fails the validation using the matcher.
+func ReasonMatches(pattern string) ReasonMatcher {
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return ReasonMatcher{description: fmt.Sprintf("matching %q", pattern), err: err}
+	}
+
+	return ReasonMatcher{description: fmt.Sprintf("matching %q", pattern), match: regex.MatchString}
+}
+
+func (m ReasonMatcher) String() string {
+	return m.description
+}
+
+// Match returns whether a reason matches.
+func (m ReasonMatcher) Match(reason string) bool {
+	return m.err == nil && m.match != nil && m.match(reason)
+}
+
+// ValidateIfReportsAreValidWithReason validates the state of a test case like ValidateIfReportsAreValid,
+// and the reason of that state: the skip reason of a skipped test case, or one of the failure
+// reasons of a failed one, i.e. the reasons of its non-compliant objects or the failing line.
+func ValidateIfReportsAreValidWithReason(tcName string, tcExpectedStatus string, reason ReasonMatcher, reportDir string) error {
+	claimReport, err := OpenClaimReport(reportDir)
+	if err != nil {
+		return fmt.Errorf("failed to open certsuite claim report, err: %w", err)
+	}
+
+	err = validateTestCaseStatus(tcName, tcExpectedStatus, *claimReport)
+	if err != nil {
+		return err
+	}
+
+	return validateTestCaseReason(tcName, tcExpectedStatus, reason, *claimReport)
+}
+
+func validateTestCaseReason(tcName string, tcExpectedStatus string, reason ReasonMatcher, claimReport claim.Root) error {
+	if reason.err != nil {
+		return fmt.Errorf("invalid reason matcher %s: %w", reason, reason.err)
+	}
+
+	tcResult, err := getTestCaseResult(tcName, claimReport)
+	if err != nil {
+		return fmt.Errorf("failed to get the result of test case %q from the claim report file, err: %w", tcName, err)
+	}
+
+	reasons, err := getTestCaseReasons(tcExpectedStatus, tcResult)
+	if err != nil {
+		return fmt.Errorf("failed to get the %s reasons of test case %q, err: %w", tcExpectedStatus, tcName, err)
+	}
+
+	for _, actual := range reasons {
+		if reason.Match(actual) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("test case %q has no %s reason %s, reasons: %q", tcName, tcExpectedStatus, reason, reasons)
+}
+
+// getTestCaseReasons returns the skip reason of a skipped test case result, or the failure reasons of
+// a failed one.
+func getTestCaseReasons(tcStatus string, tcResult *claim.Result) ([]string, error) {
+	switch tcStatus {
+	case globalparameters.TestCaseSkipped:
+		return []string{tcResult.SkipReason}, nil
+	case globalparameters.TestCaseFailed:
+		var reasons []string
+
+		if tcResult.CheckDetails != "" {
+			checkDetails, err := ParseCheckDetails(tcResult.CheckDetails)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, object := range checkDetails.NonCompliant().Objects() {
+				if reason := GetReportObjectReason(object); reason != "" {
+					reasons = append(reasons, reason)
+				}
+			}
+		}
+
+		if tcResult.FailureLineContent != "" {
+			reasons = append(reasons, tcResult.FailureLineContent)
+		}
+
+		return reasons, nil
+	default:
+		return nil, fmt.Errorf("%s test cases have no reason", tcStatus)
+	}
+}
@@ -0,0 +1,112 @@
+package globalhelper
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/stretchr/testify/assert"
+)
+
+const testReasonCheckDetails = `{
+	"CompliantObjectsOut": [],
+	"NonCompliantObjectsOut": [
+		{
+			"ObjectType": "Pod",
+			"ObjectFieldsKeys": ["Reason For Non Compliance", "Namespace", "Pod Name"],
+			"ObjectFieldsValues": ["HostPid is set to true", "qe", "pod-1"]
+		}
+	]
+}`
+
+func writeReasonTestClaim(t *testing.T) string {
+	t.Helper()
+
+	reportDir := t.TempDir()
+	claimPath := filepath.Join(reportDir, globalparameters.DefaultClaimFileName)
+
+	assert.Nil(t, writeFakeClaim(claimPath, map[string]string{
+		"access-control-pod-host-pid": globalparameters.TestCaseFailed,
+		"access-control-crd-roles":    globalparameters.TestCaseSkipped,
+		"access-control-pod-host-ipc": globalparameters.TestCasePassed,
+	}))
+
+	claimReport, err := OpenClaimFile(claimPath)
+	assert.Nil(t, err)
+
+	failedResult := claimReport.Claim.Results["access-control-pod-host-pid"]
+	failedResult.CheckDetails = testReasonCheckDetails
+	claimReport.Claim.Results["access-control-pod-host-pid"] = failedResult
+
+	skippedResult := claimReport.Claim.Results["access-control-crd-roles"]
+	skippedResult.SkipReason = "no roles to check"
+	claimReport.Claim.Results["access-control-crd-roles"] = skippedResult
+
+	encodedClaim, err := json.Marshal(claimReport)
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(claimPath, encodedClaim, 0600))
+
+	return reportDir
+}
+
+func TestReasonMatchers(t *testing.T) {
+	assert.True(t, ReasonEquals("no CRDs found").Match("no CRDs found"))
+	assert.False(t, ReasonEquals("no CRDs found").Match("no CRDs found in namespace"))
+	assert.True(t, ReasonContains("CRDs").Match("no CRDs found"))
+	assert.False(t, ReasonContains("pods").Match("no CRDs found"))
+	assert.True(t, ReasonMatches(`(?i)^no crds?\b`).Match("no CRDs found"))
+	assert.False(t, ReasonMatches(`(`).Match("("))
+	assert.Equal(t, `containing "CRDs"`, ReasonContains("CRDs").String())
+}
+
+// The skip reasons of certsuite's testhelper GetNo*SkipFn helpers.
+func TestSkipReasons(t *testing.T) {
+	testCases := []struct {
+		matcher    ReasonMatcher
+		skipReason string
+	}{
+		{SkipReasonNoContainers, "no containers to check found"},
+		{SkipReasonNoPods, "no pods to check found"},
+		{SkipReasonNoOperators, "no operators found"},
+		{SkipReasonNoCrds, "no roles to check"},
+		{SkipReasonNoSharedProcessNamespacePods, "Shared process namespace pods found."},
+	}
+
+	for _, testCase := range testCases {
+		assert.True(t, testCase.matcher.Match(testCase.skipReason), testCase.skipReason)
+		assert.True(t, testCase.matcher.Match("no nodes with realtime kernel type found, "+testCase.skipReason),
+			testCase.skipReason)
+	}
+
+	assert.False(t, SkipReasonNoContainers.Match("no pods to check found"))
+	assert.False(t, SkipReasonNoOperators.Match("no operator pods found"))
+}
+
+func TestValidateIfReportsAreValidWithReason(t *testing.T) {
+	reportDir := writeReasonTestClaim(t)
+
+	assert.Nil(t, ValidateIfReportsAreValidWithReason("access-control-crd-roles",
+		globalparameters.TestCaseSkipped, ReasonEquals("no roles to check"), reportDir))
+
+	err := ValidateIfReportsAreValidWithReason("access-control-crd-roles",
+		globalparameters.TestCaseSkipped, ReasonContains("no pods"), reportDir)
+	assert.EqualError(t, err, `test case "access-control-crd-roles" has no skipped reason containing "no pods", `+
+		`reasons: ["no roles to check"]`)
+
+	assert.Nil(t, ValidateIfReportsAreValidWithReason("access-control-pod-host-pid",
+		globalparameters.TestCaseFailed, ReasonMatches("HostPid is set"), reportDir))
+	assert.NotNil(t, ValidateIfReportsAreValidWithReason("access-control-pod-host-pid",
+		globalparameters.TestCaseFailed, ReasonContains("HostIpc"), reportDir))
+
+	// The state is validated first.
+	err = ValidateIfReportsAreValidWithReason("access-control-pod-host-pid",
+		globalparameters.TestCaseSkipped, ReasonContains("HostPid"), reportDir)
+	assert.ErrorContains(t, err, "invalid test status failed instead expected skipped")
+
+	assert.NotNil(t, ValidateIfReportsAreValidWithReason("access-control-pod-host-ipc",
+		globalparameters.TestCasePassed, ReasonContains(""), reportDir))
+	assert.ErrorContains(t, ValidateIfReportsAreValidWithReason("access-control-crd-roles",
+		globalparameters.TestCaseSkipped, ReasonMatches("("), reportDir), "invalid reason matcher")
+}
@@ -16,19 +16,38 @@ import (
 
 // OpenClaimReport opens claim.json file and returns struct.
 func OpenClaimReport(reportDir string) (*claim.Root, error) {
-	byteValueClaim, err := os.ReadFile(path.Join(reportDir, globalparameters.DefaultClaimFileName))
+	return OpenClaimFile(path.Join(reportDir, globalparameters.DefaultClaimFileName))
+}
+
+// OpenClaimFile opens the given claim file, validates it with ValidateClaim and returns struct.
+func OpenClaimFile(claimPath string) (*claim.Root, error) {
+	claimRootReport, validation, err := OpenClaimFileOfAnyFormat(claimPath)
 	if err != nil {
-		return nil, fmt.Errorf("error reading %s report file: %w", globalparameters.DefaultClaimFileName, err)
+		return nil, err
+	}
+
+	if validation.FormatProblem != "" {
+		return nil, fmt.Errorf("error validating %s report file: %w", claimPath, validation.Err())
 	}
 
-	var claimRootReport claim.Root
-	err = json.Unmarshal(byteValueClaim, &claimRootReport)
+	return claimRootReport, nil
+}
 
+// OpenClaimFileOfAnyFormat opens the given claim file like OpenClaimFile, but accepts the claim
+// formats not compatible with globalparameters.ClaimFormatVersion. Tools reading the claims of
+// other certsuite versions report the validation FormatProblem as a warning.
+func OpenClaimFileOfAnyFormat(claimPath string) (*claim.Root, *ClaimValidation, error) {
+	byteValueClaim, err := os.ReadFile(claimPath)
 	if err != nil {
-		return nil, fmt.Errorf("error unmarshalling %s report file: %w", globalparameters.DefaultClaimFileName, err)
+		return nil, nil, fmt.Errorf("error reading %s report file: %w", claimPath, err)
+	}
+
+	claimRootReport, validation := validateClaim(byteValueClaim)
+	if len(validation.Problems) > 0 {
+		return nil, validation, fmt.Errorf("error validating %s report file: %w", claimPath, validation.Err())
 	}
 
-	return &claimRootReport, nil
+	return claimRootReport, validation, nil
 }
 
 // IsTestCasePassedInClaimReport tests if test case is passed as expected in claim.json file.
@@ -173,17 +192,27 @@ func formatTestCaseName(tcName string) string {
 	return removeCharactersFromString(tcName, []string{"-", "_", " ", "online,"})
 }
 
+// tcDebugDir returns the folder where the claim and logs of a spec running the given test case
+// are kept.
+func tcDebugDir(tcName, formattedTcName string) (string, error) {
+	suiteName, err := getTestSuiteName(tcName)
+	if err != nil {
+		return "", err
+	}
+
+	return path.Join(GetConfiguration().General.ReportDirAbsPath, "Debug", suiteName, formattedTcName), nil
+}
+
 func CopyClaimFileToTcFolder(tcName, formattedTcName, reportDir string) {
 	srcClaim := path.Join(reportDir, globalparameters.DefaultClaimFileName)
 
-	suiteName, suiteErr := getTestSuiteName(tcName)
+	dstDir, suiteErr := tcDebugDir(tcName, formattedTcName)
 	if suiteErr != nil {
 		klog.ErrorS(suiteErr, "could not determine test suite name", "testCase", tcName)
 
 		return
 	}
 
-	dstDir := path.Join(GetConfiguration().General.ReportDirAbsPath, "Debug", suiteName, formattedTcName)
 	dstClaim := path.Join(dstDir, globalparameters.DefaultClaimFileName)
 
 	_, err := os.Stat(srcClaim)
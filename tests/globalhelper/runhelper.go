@@ -4,105 +4,138 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"strconv"
 	"strings"
 	"time"
 
+	. "github.com/onsi/ginkgo/v2"
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
 
 	klog "k8s.io/klog/v2"
 )
 
 const (
-	// MaxRetries is the maximum number of retries for test execution.
+	// MaxRetries is the default maximum number of attempts for test execution.
 	MaxRetries = 2
-	// TestTimeout is the timeout duration for test execution.
+	// TestTimeout is the default timeout duration for test execution.
 	TestTimeout = 30 * time.Minute
 	// MemoryLimitMB is the default soft cap for Go-managed memory when enabled.
 	MemoryLimitMB = 50
 )
 
-// executeWithRetry executes a command with timeout and retry logic.
-func executeWithRetry(cmdPath string, args []string, testCaseName string, stdout, stderr *os.File, env []string) error {
+// launchCommand is a certsuite command line run by executeWithRetry.
+type launchCommand struct {
+	path string
+	args []string
+	env  []string
+	// output receives the command stdout and stderr, it may be nil.
+	output io.Writer
+	// cleanup, when set, is called after an attempt timed out.
+	cleanup func()
+}
+
+// executeWithRetry executes a command with the timeout and attempts of the request policy. Only
+// failures classified as retryable, infrastructure errors and timeouts, are retried.
+func executeWithRetry(command launchCommand, request LaunchRequest) (*LaunchResult, error) {
 	var err error
 
-	for attempt := 1; attempt <= MaxRetries; attempt++ {
+	policy := request.launchPolicy()
+	result := &LaunchResult{}
+
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
 		// Create a context with timeout for each attempt
-		ctx, cancel := context.WithTimeout(context.Background(), TestTimeout)
+		ctx, cancel := context.WithTimeout(context.Background(), policy.Timeout)
 
 		// Create a new command for each attempt
-		cmd := exec.CommandContext(ctx, cmdPath, args...)
-		if env != nil {
-			cmd.Env = env
-		}
-
-		if stdout != nil {
-			cmd.Stdout = stdout
+		cmd := exec.CommandContext(ctx, command.path, command.args...)
+		if command.env != nil {
+			cmd.Env = command.env
 		}
 
-		if stderr != nil {
-			cmd.Stderr = stderr
-		}
+		tail := newTailWriter(launchOutputTailSize)
+		logs := newCertsuiteLogWriter(GinkgoWriter)
+		cmd.Stdout = teeWriter(command.output, tail, logs)
+		cmd.Stderr = cmd.Stdout
 
-		klog.V(5).Infof("Attempt %d/%d: Running test: %s", attempt, MaxRetries, testCaseName)
+		klog.V(5).Infof("Attempt %d/%d: Running test: %s", attempt, policy.MaxAttempts, request.LabelFilter)
 
+		start := time.Now()
 		err = cmd.Run()
+		timedOut := errors.Is(ctx.Err(), context.DeadlineExceeded)
 
-		// If the command succeeded, return success
-		if err == nil {
-			cancel()
+		cancel()
 
-			return nil
-		}
+		result.Attempts = attempt
+		result.finish(start, err, timedOut, tail.String(), request.ReportDir)
+		logs.record(result)
 
-		// Check if it was a timeout error
-		if ctx.Err() == context.DeadlineExceeded {
-			klog.V(5).Infof("Attempt %d/%d timed out after %v for test: %s",
-				attempt, MaxRetries, TestTimeout, testCaseName)
-			cancel()
+		if timedOut && command.cleanup != nil {
+			klog.V(5).Infof("Attempt %d/%d timed out after %v for test: %s, cleaning up",
+				attempt, policy.MaxAttempts, policy.Timeout, request.LabelFilter)
+			command.cleanup()
+		}
 
-			// If this wasn't the last attempt, continue retrying
-			if attempt < MaxRetries {
-				klog.V(5).Infof("Retrying test: %s", testCaseName)
+		if err == nil || !result.Retryable() {
+			break
+		}
 
-				continue
-			}
-		} else {
-			// If it's not a timeout error, don't retry
-			cancel()
+		klog.V(5).Infof("Attempt %d/%d failed for test: %s, %s", attempt, policy.MaxAttempts, request.LabelFilter, result)
 
-			break
+		if attempt < policy.MaxAttempts {
+			klog.V(5).Infof("Retrying test: %s", request.LabelFilter)
 		}
+	}
 
-		cancel()
+	return result, err
+}
+
+// teeWriter returns a writer duplicating its writes to output, if any, and the other writers.
+func teeWriter(output io.Writer, writers ...io.Writer) io.Writer {
+	if output != nil {
+		writers = append([]io.Writer{output}, writers...)
 	}
 
-	return err
+	return io.MultiWriter(writers...)
+}
+
+// binaryLauncher runs certsuite from a binary built in the certsuite repo.
+type binaryLauncher struct{}
+
+func (l *binaryLauncher) Launch(request LaunchRequest) (*LaunchResult, error) {
+	return launchTestsViaBinary(request)
 }
 
-func launchTestsViaBinary(testCaseName string, tcNameForReport string, reportDir string, configDir string) error {
+func launchTestsViaBinary(request LaunchRequest) (*LaunchResult, error) {
+	testCaseName, tcNameForReport, reportDir, configDir := request.LabelFilter, request.TcNameForReport,
+		request.ReportDir, request.ConfigDir
+
+	cmdPath, err := request.binaryPath()
+	if err != nil {
+		return nil, err
+	}
+
 	// check that the binary exists and is executable in the certsuite repo path
-	_, err := os.Stat(fmt.Sprintf("%s/%s", GetConfiguration().General.CertsuiteRepoPath,
-		GetConfiguration().General.CertsuiteEntryPointBinary))
+	_, err = os.Stat(cmdPath)
 	if err != nil {
 		klog.V(5).Infof("binary does not exist: %s. "+
 			"Please run `make build-certsuite-tool` in the certsuite repo.", err)
 
-		return fmt.Errorf("binary does not exist: %w", err)
+		return nil, fmt.Errorf("binary does not exist: %w", err)
 	}
 
 	// disable the zip file creation
 	err = os.Setenv("CERTSUITE_OMIT_ARTIFACTS_ZIP_FILE", "true")
 	if err != nil {
-		return fmt.Errorf("failed to set CERTSUITE_OMIT_ARTIFACTS_ZIP_FILE: %w", err)
+		return nil, fmt.Errorf("failed to set CERTSUITE_OMIT_ARTIFACTS_ZIP_FILE: %w", err)
 	}
 
 	// enable the collector
 	err = os.Setenv("CERTSUITE_ENABLE_DATA_COLLECTION", "false")
 	if err != nil {
-		return fmt.Errorf("failed to set CERTSUITE_ENABLE_DATA_COLLECTION: %w", err)
+		return nil, fmt.Errorf("failed to set CERTSUITE_ENABLE_DATA_COLLECTION: %w", err)
 	}
 
 	// populate the arguments for the binary
@@ -115,32 +148,31 @@ func launchTestsViaBinary(testCaseName string, tcNameForReport string, reportDir
 		"--cleanup-probe", "false",
 	}
 
-	cmdPath := fmt.Sprintf("%s/%s", GetConfiguration().General.CertsuiteRepoPath,
-		GetConfiguration().General.CertsuiteEntryPointBinary)
-
 	klog.Infof("cmd: %s %s", cmdPath, strings.Join(testArgs, " "))
 
 	debugCertsuite, err := GetConfiguration().DebugCertsuite()
 	if err != nil {
-		return fmt.Errorf("failed to set env var CERTSUITE_LOG_LEVEL: %w", err)
+		return nil, fmt.Errorf("failed to set env var CERTSUITE_LOG_LEVEL: %w", err)
 	}
 
-	var outfile *os.File
+	var output io.Writer
 
 	if debugCertsuite {
 		suiteName, suiteErr := getTestSuiteName(testCaseName)
 		if suiteErr != nil {
-			return fmt.Errorf("failed to create debug log file: %w", suiteErr)
+			return nil, fmt.Errorf("failed to create debug log file: %w", suiteErr)
 		}
 
-		outfile = GetConfiguration().CreateLogFile(suiteName, tcNameForReport)
+		outfile := GetConfiguration().CreateLogFile(suiteName, tcNameForReport)
 
 		defer outfile.Close()
 
 		_, err = fmt.Fprintf(outfile, "Running test: %s\n", tcNameForReport)
 		if err != nil {
-			return fmt.Errorf("failed to write to debug file: %w", err)
+			return nil, fmt.Errorf("failed to write to debug file: %w", err)
 		}
+
+		output = outfile
 	}
 
 	// before running, construct env with optional GOMEMLIMIT
@@ -157,34 +189,51 @@ func launchTestsViaBinary(testCaseName string, tcNameForReport string, reportDir
 		env = append(env, fmt.Sprintf("GOMEMLIMIT=%dMiB", memLimitMB))
 	}
 
-	err = executeWithRetry(cmdPath, testArgs, testCaseName, outfile, outfile, env)
+	result, err := executeWithRetry(launchCommand{path: cmdPath, args: testArgs, env: env, output: output}, request)
 	if err != nil {
-		err = fmt.Errorf("failed to run tc: %s, err: %w, cmd: %s %s",
-			testCaseName, err, cmdPath, strings.Join(testArgs, " "))
+		err = fmt.Errorf("failed to run tc: %s, err: %w, %s, cmd: %s %s",
+			testCaseName, err, result, cmdPath, strings.Join(testArgs, " "))
 	}
 
 	CopyClaimFileToTcFolder(testCaseName, tcNameForReport, reportDir)
 
-	return err
+	return result, err
+}
+
+// containerLauncher runs the certsuite image with the configured container engine.
+type containerLauncher struct{}
+
+func (l *containerLauncher) Launch(request LaunchRequest) (*LaunchResult, error) {
+	return launchTestsViaImage(request)
 }
 
-func launchTestsViaImage(testCaseName string, tcNameForReport string, reportDir string, configDir string) error {
+func launchTestsViaImage(request LaunchRequest) (*LaunchResult, error) {
+	testCaseName, tcNameForReport, reportDir, configDir := request.LabelFilter, request.TcNameForReport,
+		request.ReportDir, request.ConfigDir
+
 	// use the container to run the tests
-	// Note: Unlike launchTestsViaBinary, this function does not use executeWithRetry to avoid
-	// abandoned containers. When executeWithRetry times out, the container process may continue
-	// running even after the parent process gives up, leading to resource leaks.
+	// Note: the container is named so that it can be removed when an attempt times out. Killing
+	// the container engine client alone may leave the container running.
+	imageTag, err := request.imageTag()
+	if err != nil {
+		return nil, err
+	}
+
 	containerEngine := GetConfiguration().General.ContainerEngine
 	klog.V(5).Infof("Selected Container engine:%s", containerEngine)
 
+	containerName := "certsuite-qe-" + GenerateRandomString(10)
+
 	certsuiteCmdArgs := []string{
 		"run",
 		"--rm",
+		"--name", containerName,
 		"--network", "host",
 		"-v", fmt.Sprintf("%s:%s", os.Getenv("KUBECONFIG"), "/usr/certsuite/kubeconfig/config:Z"),
 		"-v", fmt.Sprintf("%s:%s", GetConfiguration().General.DockerConfigDir+"/config", "/usr/certsuite/dockerconfig/config:Z"),
 		"-v", fmt.Sprintf("%s:%s", configDir, "/usr/certsuite/config:Z"),
 		"-v", fmt.Sprintf("%s:%s", reportDir, "/usr/certsuite/results:Z"),
-		fmt.Sprintf("%s:%s", GetConfiguration().General.CertsuiteImage, GetConfiguration().General.CertsuiteImageTag),
+		fmt.Sprintf("%s:%s", GetConfiguration().General.CertsuiteImage, imageTag),
 		"certsuite",
 		"run",
 		"--kubeconfig", "/usr/certsuite/kubeconfig/config",
@@ -201,17 +250,21 @@ func launchTestsViaImage(testCaseName string, tcNameForReport string, reportDir
 	// print the command
 	klog.V(5).Infof("Running command: %s %s", containerEngine, strings.Join(certsuiteCmdArgs, " "))
 
-	cmd := exec.CommandContext(context.TODO(), containerEngine, certsuiteCmdArgs...)
-
 	debugCertsuite, err := GetConfiguration().DebugCertsuite()
 	if err != nil {
-		return fmt.Errorf("failed to set env var CERTSUITE_LOG_LEVEL: %w", err)
+		return nil, fmt.Errorf("failed to set env var CERTSUITE_LOG_LEVEL: %w", err)
 	}
 
+	var (
+		output      io.Writer
+		outFileName string
+		env         []string
+	)
+
 	if debugCertsuite {
 		suiteName, suiteErr := getTestSuiteName(testCaseName)
 		if suiteErr != nil {
-			return fmt.Errorf("failed to create debug log file: %w", suiteErr)
+			return nil, fmt.Errorf("failed to create debug log file: %w", suiteErr)
 		}
 
 		outfile := GetConfiguration().CreateLogFile(suiteName, tcNameForReport)
@@ -220,11 +273,11 @@ func launchTestsViaImage(testCaseName string, tcNameForReport string, reportDir
 
 		_, err = fmt.Fprintf(outfile, "Running test: %s\n", tcNameForReport)
 		if err != nil {
-			return fmt.Errorf("failed to write to debug file: %w", err)
+			return nil, fmt.Errorf("failed to write to debug file: %w", err)
 		}
 
-		cmd.Stdout = outfile
-		cmd.Stderr = outfile
+		output = outfile
+		outFileName = outfile.Name()
 	}
 
 	// set optional GOMEMLIMIT inside container
@@ -236,35 +289,88 @@ func launchTestsViaImage(testCaseName string, tcNameForReport string, reportDir
 				memLimitMB = parsed
 			}
 		}
-		cmd.Env = append(os.Environ(), fmt.Sprintf("GOMEMLIMIT=%dMiB", memLimitMB))
+		env = append(os.Environ(), fmt.Sprintf("GOMEMLIMIT=%dMiB", memLimitMB))
 	}
 
-	err = cmd.Run()
+	result, err := executeWithRetry(launchCommand{
+		path:    containerEngine,
+		args:    certsuiteCmdArgs,
+		env:     env,
+		output:  output,
+		cleanup: func() { removeContainer(containerEngine, containerName) },
+	}, request)
 	if err != nil {
-		errStr := fmt.Sprintf("failed to run tc: %s, err: %v, cmd: %s",
-			testCaseName, err, cmd.String())
-		if debugCertsuite && cmd.Stdout != nil {
-			if outfile, ok := cmd.Stdout.(*os.File); ok {
-				errStr += ", outFile=" + outfile.Name()
-			}
+		errStr := fmt.Sprintf("failed to run tc: %s, err: %v, %s, cmd: %s %s",
+			testCaseName, err, result, containerEngine, strings.Join(certsuiteCmdArgs, " "))
+		if outFileName != "" {
+			errStr += ", outFile=" + outFileName
 		}
 
-		return errors.New(errStr)
+		return result, errors.New(errStr)
 	}
 
 	CopyClaimFileToTcFolder(testCaseName, tcNameForReport, reportDir)
 
-	return nil
+	return result, nil
+}
+
+// removeContainer force removes a certsuite container left behind by a timed out run.
+func removeContainer(containerEngine, containerName string) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, containerEngine, "rm", "-f", containerName).CombinedOutput()
+	if err != nil {
+		klog.ErrorS(err, "failed to remove certsuite container", "container", containerName, "output", string(output))
+	}
 }
 
-// LaunchTests stats tests based on given parameters.
+// LaunchTests runs the given certsuite test case with the launcher selected in the configuration.
 func LaunchTests(testCaseName string, tcNameForReport string, reportDir string, configDir string) error {
-	// check if the `USE_BINARY` flag is set, if so, run the binary version of the tests
-	if GetConfiguration().General.UseBinary == "true" {
-		return launchTestsViaBinary(testCaseName, tcNameForReport, reportDir, configDir)
+	_, err := LaunchTestsWithResult(testCaseName, tcNameForReport, reportDir, configDir)
+
+	return err
+}
+
+// LaunchTestsWithResult runs the given certsuite test case like LaunchTests and also returns
+// the outcome of the run. The result is nil when certsuite could not be started. When a certsuite
+// version matrix is configured, the test case is run again with each version and an error is
+// returned if the results differ.
+func LaunchTestsWithResult(testCaseName string, tcNameForReport string, reportDir string,
+	configDir string) (*LaunchResult, error) {
+	launcher, err := GetLauncher(GetConfiguration().LauncherName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to run tc: %s, err: %w", testCaseName, err)
+	}
+
+	request := LaunchRequest{
+		LabelFilter:     testCaseName,
+		TcNameForReport: tcNameForReport,
+		ReportDir:       reportDir,
+		ConfigDir:       configDir,
+		Policy:          GetLaunchPolicy(testCaseName),
+	}
+	request.Policy.Timeout = GetConfiguration().ScaleTimeout(request.Policy.Timeout)
+
+	result, err := launcher.Launch(request)
+
+	if result != nil {
+		klog.V(5).Infof("certsuite run for tc: %s finished, %s", testCaseName, result)
+		reportLaunchResult(result)
+	}
+
+	rememberDebugDir(reportDir, testCaseName, tcNameForReport)
+
+	logErr := checkCertsuiteLog(reportDir, result)
+	if err == nil && logErr != nil {
+		err = fmt.Errorf("failed to run tc: %s, err: %w", testCaseName, logErr)
+	}
+
+	if err == nil && len(GetConfiguration().General.CertsuiteVersionMatrix) > 0 {
+		err = runVersionMatrix(launcher, request, GetConfiguration().General.CertsuiteVersionMatrix)
 	}
 
-	return launchTestsViaImage(testCaseName, tcNameForReport, reportDir, configDir)
+	return result, err
 }
 
 // suiteNames lists every known suite name for getTestSuiteName lookups.
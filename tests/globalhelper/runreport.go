@@ -0,0 +1,383 @@
+package globalhelper
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-claim/pkg/claim"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+)
+
+const (
+	runReportDebugDirName = "Debug"
+	junitItPrefix         = "[It] "
+)
+
+// RunReportRow is a certsuite test case run by a spec.
+type RunReportRow struct {
+	Suite string
+	// Spec is the spec full text, or its debug folder name when the spec recorded no result.
+	Spec         string
+	SpecState    string
+	SpecDuration time.Duration
+	// TestCase is the certsuite test ID.
+	TestCase string
+	// Expected are the states accepted by the spec, Actual the state found in the claim.
+	Expected            []string
+	Actual              string
+	NonCompliantObjects []string
+	CertsuiteDuration   time.Duration
+	// Files are the debug folder files, relative to the report directory.
+	Files []string
+	// Error is set when the debug folder claim could not be read.
+	Error string
+	// Warning is set when the debug folder claim format is not the supported one.
+	Warning string
+}
+
+// Mismatch returns true when the claim state is not one of the states the spec expected.
+func (r RunReportRow) Mismatch() bool {
+	return len(r.Expected) > 0 && !slices.Contains(r.Expected, r.Actual)
+}
+
+// RunReport aggregates the claims copied in the Debug folders of a report directory, and the
+// ginkgo JUnit reports of the suites.
+type RunReport struct {
+	ReportDir string
+	Rows      []RunReportRow
+}
+
+// Mismatches returns the number of rows whose claim state is not the expected one.
+func (r *RunReport) Mismatches() int {
+	count := 0
+
+	for _, row := range r.Rows {
+		if row.Mismatch() {
+			count++
+		}
+	}
+
+	return count
+}
+
+type junitSpec struct {
+	suite    string
+	name     string
+	state    string
+	duration time.Duration
+	matched  bool
+}
+
+// BuildRunReport reads the <reportDir>/Debug/<suite>/<spec> folders and the JUnit XML reports
+// of reportDir.
+func BuildRunReport(reportDir string) (*RunReport, error) {
+	junitSpecs, err := readJUnitSpecs(reportDir)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RunReport{ReportDir: reportDir}
+
+	specDirs, err := filepath.Glob(filepath.Join(reportDir, runReportDebugDirName, "*", "*"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list debug folders of %s: %w", reportDir, err)
+	}
+
+	for _, specDir := range specDirs {
+		if info, err := os.Stat(specDir); err != nil || !info.IsDir() {
+			continue
+		}
+
+		rows, err := buildRunReportRows(reportDir, specDir, junitSpecs)
+		if err != nil {
+			return nil, err
+		}
+
+		report.Rows = append(report.Rows, rows...)
+	}
+
+	// Specs that failed before certsuite wrote a claim have no debug folder.
+	for _, spec := range junitSpecs {
+		if spec.matched || spec.state == "skipped" {
+			continue
+		}
+
+		report.Rows = append(report.Rows, RunReportRow{
+			Suite:        spec.suite,
+			Spec:         strings.TrimPrefix(spec.name, junitItPrefix),
+			SpecState:    spec.state,
+			SpecDuration: spec.duration,
+		})
+	}
+
+	sort.SliceStable(report.Rows, func(i, j int) bool {
+		if report.Rows[i].Suite != report.Rows[j].Suite {
+			return report.Rows[i].Suite < report.Rows[j].Suite
+		}
+
+		if report.Rows[i].Spec != report.Rows[j].Spec {
+			return report.Rows[i].Spec < report.Rows[j].Spec
+		}
+
+		return report.Rows[i].TestCase < report.Rows[j].TestCase
+	})
+
+	return report, nil
+}
+
+func buildRunReportRows(reportDir, specDir string, junitSpecs []*junitSpec) ([]RunReportRow, error) {
+	results, err := ReadCertsuiteResults(specDir)
+	if err != nil {
+		return nil, err
+	}
+
+	baseRow := RunReportRow{Suite: filepath.Base(filepath.Dir(specDir)), Spec: filepath.Base(specDir)}
+
+	if len(results) > 0 {
+		baseRow.Spec = results[0].Spec
+	}
+
+	baseRow.Files, err = listRunReportFiles(reportDir, specDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec := matchJUnitSpec(junitSpecs, baseRow.Spec); spec != nil {
+		baseRow.SpecState = spec.state
+		baseRow.SpecDuration = spec.duration
+	}
+
+	var claimRoot *claim.Root
+
+	claimPath := filepath.Join(specDir, globalparameters.DefaultClaimFileName)
+	if _, err := os.Stat(claimPath); err == nil {
+		var validation *ClaimValidation
+
+		claimRoot, validation, err = OpenClaimFileOfAnyFormat(claimPath)
+		if err != nil {
+			baseRow.Error = err.Error()
+		} else if validation.FormatProblem != "" {
+			baseRow.Warning = fmt.Sprintf("warning: %s, supported %s", validation.FormatProblem,
+				globalparameters.ClaimFormatVersion)
+		}
+	}
+
+	tcNames := claimTestCaseNames(claimRoot)
+	if len(results) > 0 {
+		tcNames = nil
+
+		for _, result := range results {
+			tcNames = append(tcNames, result.TestCase)
+		}
+	}
+
+	if len(tcNames) == 0 {
+		return []RunReportRow{baseRow}, nil
+	}
+
+	rows := make([]RunReportRow, 0, len(tcNames))
+
+	for _, tcName := range tcNames {
+		row := baseRow
+		row.TestCase = tcName
+
+		for _, result := range results {
+			if result.TestCase == tcName {
+				row.Expected = result.Expected
+			}
+		}
+
+		if tcResult, err := claimTestCaseResult(tcName, claimRoot); err == nil {
+			row.Actual = tcResult.State
+			row.CertsuiteDuration = time.Duration(tcResult.Duration)
+			row.NonCompliantObjects = reportObjectKeys(parseCheckDetailsOrEmpty(tcResult.CheckDetails).NonCompliantObjectsOut)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func listRunReportFiles(reportDir, specDir string) ([]string, error) {
+	entries, err := os.ReadDir(specDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", specDir, err)
+	}
+
+	var files []string
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		relativePath, err := filepath.Rel(reportDir, filepath.Join(specDir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the relative path of %s: %w", entry.Name(), err)
+		}
+
+		files = append(files, filepath.ToSlash(relativePath))
+	}
+
+	return files, nil
+}
+
+// readJUnitSpecs reads the specs of the JUnit XML reports of a directory. Files that are not
+// JUnit reports are ignored.
+func readJUnitSpecs(reportDir string) ([]*junitSpec, error) {
+	junitReports, err := readJUnitReports(reportDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var specs []*junitSpec
+
+	for _, junitReport := range junitReports {
+		for _, suite := range junitReport.TestSuites {
+			for _, testCase := range suite.TestCases {
+				if !strings.HasPrefix(testCase.Name, junitItPrefix) {
+					continue
+				}
+
+				specs = append(specs, &junitSpec{
+					suite:    suite.Name,
+					name:     testCase.Name,
+					state:    testCase.Status,
+					duration: time.Duration(testCase.Time * float64(time.Second)),
+				})
+			}
+		}
+	}
+
+	return specs, nil
+}
+
+// matchJUnitSpec finds the JUnit spec of a spec full text. JUnit names are the full text
+// prefixed with the node type and followed by the spec labels.
+func matchJUnitSpec(specs []*junitSpec, specText string) *junitSpec {
+	for _, spec := range specs {
+		name := strings.TrimPrefix(spec.name, junitItPrefix)
+		if name == specText || strings.HasPrefix(name, specText+" [") {
+			spec.matched = true
+
+			return spec
+		}
+	}
+
+	return nil
+}
+
+// WriteMarkdown writes the run report as a Markdown table.
+func (r *RunReport) WriteMarkdown(writer io.Writer) error {
+	lines := []string{
+		"# Certsuite QE run report",
+		"",
+		fmt.Sprintf("%d certsuite test cases, %d not in the expected state.", len(r.Rows), r.Mismatches()),
+		"",
+		"| Suite | Spec | Spec state | Certsuite test ID | Expected | Actual | Non-compliant objects | " +
+			"Certsuite duration | Spec duration | Debug files |",
+		"| --- | --- | --- | --- | --- | --- | --- | --- | --- | --- |",
+	}
+
+	for _, row := range r.Rows {
+		actual := row.Actual
+		if row.Mismatch() {
+			actual = "**" + actual + "** :x:"
+		}
+
+		for _, message := range []string{row.Error, row.Warning} {
+			if message != "" {
+				actual = strings.TrimSpace(actual + " " + message)
+			}
+		}
+
+		links := make([]string, 0, len(row.Files))
+		for _, file := range row.Files {
+			links = append(links, fmt.Sprintf("[%s](%s)", filepath.Base(file), file))
+		}
+
+		lines = append(lines, "| "+strings.Join([]string{
+			markdownCell(row.Suite),
+			markdownCell(row.Spec),
+			markdownCell(row.SpecState),
+			markdownCell(row.TestCase),
+			markdownCell(strings.Join(row.Expected, " or ")),
+			markdownCell(actual),
+			markdownCell(strings.Join(row.NonCompliantObjects, "<br>")),
+			formatRunReportDuration(row.CertsuiteDuration),
+			formatRunReportDuration(row.SpecDuration),
+			strings.Join(links, " "),
+		}, " | ")+" |")
+	}
+
+	_, err := io.WriteString(writer, strings.Join(lines, "\n")+"\n")
+
+	return err
+}
+
+func markdownCell(value string) string {
+	return strings.NewReplacer("|", "\\|", "\n", " ").Replace(value)
+}
+
+func formatRunReportDuration(duration time.Duration) string {
+	if duration == 0 {
+		return ""
+	}
+
+	return duration.Round(time.Second).String()
+}
+
+var runReportHTMLTemplate = template.Must(template.New("run-report").Funcs(template.FuncMap{
+	"join":     strings.Join,
+	"base":     filepath.Base,
+	"duration": formatRunReportDuration,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Certsuite QE run report</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; vertical-align: top; }
+th { background: #eee; }
+tr.mismatch td { background: #fdd; }
+</style>
+</head>
+<body>
+<h1>Certsuite QE run report</h1>
+<p>{{ len .Rows }} certsuite test cases, {{ .Mismatches }} not in the expected state.</p>
+<table>
+<tr><th>Suite</th><th>Spec</th><th>Spec state</th><th>Certsuite test ID</th><th>Expected</th><th>Actual</th>` +
+	`<th>Non-compliant objects</th><th>Certsuite duration</th><th>Spec duration</th><th>Debug files</th></tr>
+{{- range .Rows }}
+<tr{{ if .Mismatch }} class="mismatch"{{ end }}>
+<td>{{ .Suite }}</td>
+<td>{{ .Spec }}</td>
+<td>{{ .SpecState }}</td>
+<td>{{ .TestCase }}</td>
+<td>{{ join .Expected " or " }}</td>
+<td>{{ .Actual }}{{ if .Error }} {{ .Error }}{{ end }}{{ if .Warning }} {{ .Warning }}{{ end }}</td>
+<td>{{ range .NonCompliantObjects }}{{ . }}<br>{{ end }}</td>
+<td>{{ duration .CertsuiteDuration }}</td>
+<td>{{ duration .SpecDuration }}</td>
+<td>{{ range .Files }}<a href="{{ . }}">{{ base . }}</a><br>{{ end }}</td>
+</tr>
+{{- end }}
+</table>
+</body>
+</html>
+`))
+
+// WriteHTML writes the run report as an HTML page.
+func (r *RunReport) WriteHTML(writer io.Writer) error {
+	return runReportHTMLTemplate.Execute(writer, r)
+}
@@ -0,0 +1,141 @@
+package globalhelper
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testRunReportSpec       = "Access-control pod-host-pid one deployment, one pod, HostPid true [negative]"
+	testRunReportFailedSpec = "Access-control pod-host-ipc one deployment, one pod, HostIpc true [negative]"
+)
+
+func writeTestRunReportDir(t *testing.T) string {
+	t.Helper()
+
+	reportDir := t.TempDir()
+	specDir := filepath.Join(reportDir, runReportDebugDirName, globalparameters.AccessControlSuiteName,
+		ConvertSpecNameToFileName(testRunReportSpec))
+
+	assert.Nil(t, os.MkdirAll(specDir, globalparameters.DirPermissions))
+	assert.Nil(t, writeFakeClaim(filepath.Join(specDir, globalparameters.DefaultClaimFileName), map[string]string{
+		"access-control-pod-host-pid": globalparameters.TestCasePassed,
+	}))
+	assert.Nil(t, os.WriteFile(filepath.Join(specDir, "certsuite.log"), []byte("log"), 0600))
+	assert.Nil(t, writeCertsuiteResult(specDir, CertsuiteResult{
+		Spec:     testRunReportSpec,
+		TestCase: "access-control-pod-host-pid",
+		Expected: []string{globalparameters.TestCaseFailed},
+		Actual:   globalparameters.TestCasePassed,
+	}))
+
+	report := types.Report{
+		SuiteDescription: "CNFCert access-control tests",
+		SpecReports: types.SpecReports{
+			{
+				LeafNodeType:            types.NodeTypeIt,
+				ContainerHierarchyTexts: []string{"Access-control pod-host-pid"},
+				LeafNodeText:            "one deployment, one pod, HostPid true [negative]",
+				LeafNodeLabels:          []string{"accesscontrol9"},
+				State:                   types.SpecStateFailed,
+				RunTime:                 90 * time.Second,
+			},
+			{
+				LeafNodeType:            types.NodeTypeIt,
+				ContainerHierarchyTexts: []string{"Access-control pod-host-ipc"},
+				LeafNodeText:            "one deployment, one pod, HostIpc true [negative]",
+				State:                   types.SpecStateFailed,
+			},
+			{
+				LeafNodeType:            types.NodeTypeIt,
+				ContainerHierarchyTexts: []string{"Access-control pod-host-path"},
+				LeafNodeText:            "skipped spec",
+				State:                   types.SpecStateSkipped,
+			},
+		},
+	}
+
+	assert.Nil(t, writeJUnitReport(report, filepath.Join(reportDir, "access_control_suite_test.xml"), nil))
+	assert.Nil(t, os.WriteFile(filepath.Join(reportDir, "other.xml"), []byte("<other/>"), 0600))
+
+	return reportDir
+}
+
+func TestBuildRunReport(t *testing.T) {
+	reportDir := writeTestRunReportDir(t)
+
+	report, err := BuildRunReport(reportDir)
+	assert.Nil(t, err)
+	assert.Len(t, report.Rows, 2)
+	assert.Equal(t, 1, report.Mismatches())
+
+	row := report.Rows[1]
+	assert.Equal(t, globalparameters.AccessControlSuiteName, row.Suite)
+	assert.Equal(t, testRunReportSpec, row.Spec)
+	assert.Equal(t, "failed", row.SpecState)
+	assert.Equal(t, 90*time.Second, row.SpecDuration)
+	assert.Equal(t, "access-control-pod-host-pid", row.TestCase)
+	assert.Equal(t, []string{globalparameters.TestCaseFailed}, row.Expected)
+	assert.Equal(t, globalparameters.TestCasePassed, row.Actual)
+	assert.True(t, row.Mismatch())
+	assert.Len(t, row.Files, 3)
+
+	// The failed spec has no debug folder.
+	assert.Equal(t, "CNFCert access-control tests", report.Rows[0].Suite)
+	assert.Equal(t, testRunReportFailedSpec, report.Rows[0].Spec)
+	assert.Empty(t, report.Rows[0].TestCase)
+
+	var markdown strings.Builder
+
+	assert.Nil(t, report.WriteMarkdown(&markdown))
+	assert.Contains(t, markdown.String(), "2 certsuite test cases, 1 not in the expected state.")
+	assert.Contains(t, markdown.String(), "| **passed** :x: |")
+	assert.Contains(t, markdown.String(), "[certsuite.log](Debug/access-control/")
+
+	var html strings.Builder
+
+	assert.Nil(t, report.WriteHTML(&html))
+	assert.Contains(t, html.String(), `<tr class="mismatch">`)
+	assert.Contains(t, html.String(), "access-control-pod-host-pid")
+}
+
+func TestBuildRunReportOtherClaimFormat(t *testing.T) {
+	reportDir := writeTestRunReportDir(t)
+	claimPath := filepath.Join(reportDir, runReportDebugDirName, globalparameters.AccessControlSuiteName,
+		ConvertSpecNameToFileName(testRunReportSpec), globalparameters.DefaultClaimFileName)
+
+	content, err := os.ReadFile(claimPath)
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(claimPath, []byte(strings.Replace(string(content),
+		`"claimFormat": "`+globalparameters.ClaimFormatVersion+`"`, `"claimFormat": "v0.6.0"`, 1)), 0600))
+
+	report, err := BuildRunReport(reportDir)
+	assert.Nil(t, err)
+	assert.Empty(t, report.Rows[1].Error)
+	assert.Equal(t, "warning: incompatible claim format version v0.6.0, supported "+globalparameters.ClaimFormatVersion,
+		report.Rows[1].Warning)
+	assert.Equal(t, "access-control-pod-host-pid", report.Rows[1].TestCase)
+}
+
+func TestWriteCertsuiteResult(t *testing.T) {
+	debugDir := filepath.Join(t.TempDir(), "spec")
+
+	results, err := ReadCertsuiteResults(debugDir)
+	assert.Nil(t, err)
+	assert.Empty(t, results)
+
+	assert.Nil(t, writeCertsuiteResult(debugDir, CertsuiteResult{TestCase: "tc1", Actual: "failed"}))
+	assert.Nil(t, writeCertsuiteResult(debugDir, CertsuiteResult{TestCase: "tc2", Actual: "passed"}))
+	assert.Nil(t, writeCertsuiteResult(debugDir, CertsuiteResult{TestCase: "tc1", Actual: "passed"}))
+
+	results, err = ReadCertsuiteResults(debugDir)
+	assert.Nil(t, err)
+	assert.Equal(t, []CertsuiteResult{{TestCase: "tc1", Actual: "passed"}, {TestCase: "tc2", Actual: "passed"}}, results)
+}
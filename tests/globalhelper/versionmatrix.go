@@ -0,0 +1,82 @@
+package globalhelper
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	klog "k8s.io/klog/v2"
+)
+
+// versionMatrixDirName is the report sub directory holding the claim of each matrix version.
+const versionMatrixDirName = "versions"
+
+var versionDirNameRegex = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// runVersionMatrix runs the request once per certsuite version of the matrix and compares the
+// results with the claim already written in the request report directory. A version containing
+// a "/" is a certsuite binary path, any other version is an image tag. Each version writes its
+// claim in <reportDir>/versions/<version>.
+func runVersionMatrix(launcher Launcher, request LaunchRequest, versions []string) error {
+	baseClaim, err := OpenClaimReport(request.ReportDir)
+	if err != nil {
+		return fmt.Errorf("failed to open the claim to compare the certsuite version matrix with: %w", err)
+	}
+
+	var problems []string
+
+	for _, version := range versions {
+		versionRequest := versionMatrixRequest(request, version)
+
+		klog.V(5).Infof("Running tc: %s with certsuite version %s", request.LabelFilter, version)
+
+		_, err = launcher.Launch(versionRequest)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", version, err))
+
+			continue
+		}
+
+		versionClaim, err := OpenClaimReport(versionRequest.ReportDir)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", version, err))
+
+			continue
+		}
+
+		for _, difference := range DiffClaims(baseClaim, versionClaim, splitLabelFilter(request.LabelFilter)...) {
+			problems = append(problems, fmt.Sprintf("%s: %s", version, difference))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	if CurrentSpecReport().LeafNodeType != types.NodeTypeInvalid {
+		AddReportEntry("certsuite version matrix differences", strings.Join(problems, "\n"))
+	}
+
+	return fmt.Errorf("certsuite version matrix results differ for tc: %s:\n%s", request.LabelFilter,
+		strings.Join(problems, "\n"))
+}
+
+func versionMatrixRequest(request LaunchRequest, version string) LaunchRequest {
+	dirName := versionDirNameRegex.ReplaceAllString(strings.Trim(version, "/"), "_")
+
+	versionRequest := request
+	versionRequest.ReportDir = path.Join(request.ReportDir, versionMatrixDirName, dirName)
+	versionRequest.TcNameForReport = request.TcNameForReport + "_" + dirName
+	versionRequest.ImageTag, versionRequest.BinaryPath = "", ""
+
+	if strings.Contains(version, "/") {
+		versionRequest.BinaryPath = version
+	} else {
+		versionRequest.ImageTag = version
+	}
+
+	return versionRequest
+}
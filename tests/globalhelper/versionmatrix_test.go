@@ -0,0 +1,68 @@
+package globalhelper
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// versionLauncher writes a claim whose results depend on the requested image tag.
+type versionLauncher struct {
+	results map[string]map[string]string
+}
+
+func (l *versionLauncher) Launch(request LaunchRequest) (*LaunchResult, error) {
+	return (&FakeLauncher{Results: l.results[request.ImageTag]}).Launch(request)
+}
+
+func TestLaunchTestsWithVersionMatrix(t *testing.T) {
+	originalConf := conf
+
+	defer func() { conf = originalConf }()
+
+	RegisterLauncher("version-matrix-test", &versionLauncher{results: map[string]map[string]string{
+		"v2": {"observability-crd-status": globalparameters.TestCaseFailed},
+	}})
+
+	conf = &config.Config{}
+	conf.General.Launcher = "version-matrix-test"
+	conf.General.ReportDirAbsPath = t.TempDir()
+	conf.General.CertsuiteVersionMatrix = []string{"v1"}
+
+	reportDir := t.TempDir()
+
+	err := LaunchTests("observability-container-logging || observability-crd-status", "matrix", reportDir, t.TempDir())
+	assert.Nil(t, err)
+
+	_, err = os.Stat(path.Join(reportDir, versionMatrixDirName, "v1", globalparameters.DefaultClaimFileName))
+	assert.Nil(t, err)
+
+	conf.General.CertsuiteVersionMatrix = []string{"v1", "v2"}
+
+	err = LaunchTests("observability-container-logging || observability-crd-status", "matrix", reportDir, t.TempDir())
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "v2: observability-crd-status: state differs: [passed] != [failed]")
+	assert.NotContains(t, err.Error(), "v2: observability-container-logging")
+}
+
+func TestVersionMatrixRequest(t *testing.T) {
+	request := LaunchRequest{ReportDir: "/tmp/report", TcNameForReport: "tc", ImageTag: "v0"}
+
+	versionRequest := versionMatrixRequest(request, "v5.1.0")
+	assert.Equal(t, "/tmp/report/versions/v5.1.0", versionRequest.ReportDir)
+	assert.Equal(t, "tc_v5.1.0", versionRequest.TcNameForReport)
+	assert.Equal(t, "v5.1.0", versionRequest.ImageTag)
+	assert.Empty(t, versionRequest.BinaryPath)
+
+	versionRequest = versionMatrixRequest(request, "/opt/certsuite-v5/certsuite")
+	assert.Equal(t, "/tmp/report/versions/opt_certsuite-v5_certsuite", versionRequest.ReportDir)
+	assert.Equal(t, "/opt/certsuite-v5/certsuite", versionRequest.BinaryPath)
+	assert.Empty(t, versionRequest.ImageTag)
+
+	_, err := versionRequest.imageTag()
+	assert.NotNil(t, err)
+}
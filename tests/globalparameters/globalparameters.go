@@ -17,19 +17,52 @@ const (
 )
 
 type (
+	// CertsuiteConfig is the certsuite_config.yml certsuite reads its targets and exceptions from.
+	//nolint:lll
 	CertsuiteConfig struct {
-		TargetNameSpaces         []TargetNameSpace            `yaml:"targetNameSpaces" json:"targetNameSpaces"`
-		PodsUnderTestLabels      []string                     `yaml:"podsUnderTestLabels" json:"podsUnderTestLabels"`
-		OperatorsUnderTestLabels []string                     `yaml:"operatorsUnderTestLabels" json:"operatorsUnderTestLabels"`
-		Certifiedcontainerinfo   []CertifiedContainerRepoInfo `yaml:"certifiedcontainerinfo" json:"certifiedcontainerinfo"`
-		TargetCrdFilters         []TargetCrdFilter            `yaml:"targetCrdFilters" json:"targetCrdFilters"`
-		ExecutedBy               string                       `yaml:"executedBy" json:"executedBy"`
-		CollectorAppPassword     string                       `yaml:"CollectorAppPassword" json:"CollectorAppPassword"`
-		PartnerName              string                       `yaml:"partnerName" json:"partnerName"`
+		TargetNameSpaces            []TargetNameSpace            `yaml:"targetNameSpaces" json:"targetNameSpaces"`
+		PodsUnderTestLabels         []string                     `yaml:"podsUnderTestLabels" json:"podsUnderTestLabels"`
+		OperatorsUnderTestLabels    []string                     `yaml:"operatorsUnderTestLabels" json:"operatorsUnderTestLabels"`
+		Certifiedcontainerinfo      []CertifiedContainerRepoInfo `yaml:"certifiedcontainerinfo" json:"certifiedcontainerinfo"`
+		TargetCrdFilters            []TargetCrdFilter            `yaml:"targetCrdFilters" json:"targetCrdFilters"`
+		ManagedDeployments          []ManagedController          `yaml:"managedDeployments,omitempty" json:"managedDeployments,omitempty"`
+		ManagedStatefulsets         []ManagedController          `yaml:"managedStatefulsets,omitempty" json:"managedStatefulsets,omitempty"`
+		AcceptedKernelTaints        []AcceptedKernelTaint        `yaml:"acceptedKernelTaints,omitempty" json:"acceptedKernelTaints,omitempty"`
+		SkipHelmChartList           []SkipHelmChart              `yaml:"skipHelmChartList,omitempty" json:"skipHelmChartList,omitempty"`
+		SkipScalingTestDeployments  []SkipScalingTestObject      `yaml:"skipScalingTestDeployments,omitempty" json:"skipScalingTestDeployments,omitempty"`
+		SkipScalingTestStatefulSets []SkipScalingTestObject      `yaml:"skipScalingTestStatefulsets,omitempty" json:"skipScalingTestStatefulsets,omitempty"`
+		ValidProtocolNames          []string                     `yaml:"validProtocolNames,omitempty" json:"validProtocolNames,omitempty"`
+		ServicesIgnoreList          []string                     `yaml:"servicesignorelist,omitempty" json:"servicesignorelist,omitempty"`
+		ProbeDaemonSetNamespace     string                       `yaml:"probeDaemonSetNamespace,omitempty" json:"probeDaemonSetNamespace,omitempty"`
+		ExecutedBy                  string                       `yaml:"executedBy" json:"executedBy"`
+		CollectorAppPassword        string                       `yaml:"CollectorAppPassword" json:"CollectorAppPassword"`
+		PartnerName                 string                       `yaml:"partnerName" json:"partnerName"`
 	}
 
 	TargetCrdFilter struct {
 		NameSuffix string `yaml:"nameSuffix" json:"nameSuffix"`
+		// Scalable allows certsuite to scale the custom resources of the CRD.
+		Scalable bool `yaml:"scalable,omitempty" json:"scalable,omitempty"`
+	}
+
+	// ManagedController is a deployment or statefulset managed by a custom resource, certsuite
+	// checks the custom resource instead of the controller.
+	ManagedController struct {
+		Name string `yaml:"name" json:"name"`
+	}
+
+	AcceptedKernelTaint struct {
+		Module string `yaml:"module" json:"module"`
+	}
+
+	SkipHelmChart struct {
+		Name string `yaml:"name" json:"name"`
+	}
+
+	// SkipScalingTestObject is a deployment or statefulset certsuite does not scale.
+	SkipScalingTestObject struct {
+		Name      string `yaml:"name" json:"name"`
+		Namespace string `yaml:"namespace" json:"namespace"`
 	}
 
 	TargetNameSpace struct {
@@ -92,6 +125,13 @@ type (
 		Name  string `xml:"name,attr"`
 		Value string `xml:"value,attr"`
 	}
+
+	// LaunchPolicy sets how long a certsuite run may last and how many times it is attempted.
+	// Zero values fall back to the global defaults.
+	LaunchPolicy struct {
+		Timeout     time.Duration
+		MaxAttempts int
+	}
 )
 
 var (
@@ -112,4 +152,11 @@ var (
 	ManageabilitySuiteName           = "manageability"
 	OperatorSuiteName                = "operator"
 	PreflightSuiteName               = "preflight"
+	BinaryLauncherName               = "binary"
+	ContainerLauncherName            = "container"
+	FakeLauncherName                 = "fake"
+	JobLauncherName                  = "job"
+	// ClaimFormatVersion is the claim format read by the certsuite-claim model. Claims with another
+	// major version, or another minor version while the major version is 0, are incompatible.
+	ClaimFormatVersion = "v0.5.0"
 )
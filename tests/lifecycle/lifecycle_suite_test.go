@@ -14,15 +14,17 @@ import (
 
 	. "github.com/onsi/gomega"
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
+	tsparams "github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/lifecycle/parameters"
 
 	tshelper "github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/lifecycle/helper"
 )
 
 func TestLifecycle(t *testing.T) {
+	globalhelper.RegisterLaunchPolicies(tsparams.LaunchPolicies)
 	globalhelper.RunSuite(t, "CNFCert lifecycle tests")
 }
 
-var _ = SynchronizedBeforeSuite(func() {
+var _ = SynchronizedBeforeSuite(func() []byte {
 	configSuite, err := config.NewConfig()
 	if err != nil {
 		klog.Fatalf("can not load config file: %v", err)
@@ -34,7 +36,18 @@ var _ = SynchronizedBeforeSuite(func() {
 	By("Ensure all nodes are labeled with 'worker-cnf' label")
 	err = nodes.EnsureAllNodesAreLabeled(configSuite.General.CnfNodeLabel)
 	Expect(err).ToNot(HaveOccurred())
-}, func() {})
+
+	By("Check certsuite image")
+	Expect(globalhelper.ValidateCertsuiteImage()).To(Succeed(), "Error checking certsuite image")
+
+	By("Build certsuite binary")
+	certsuiteBinary, err := globalhelper.BuildCertsuiteBinary()
+	Expect(err).ToNot(HaveOccurred(), "Error building certsuite binary")
+
+	return []byte(certsuiteBinary)
+}, func(certsuiteBinary []byte) {
+	globalhelper.SetCertsuiteBinary(string(certsuiteBinary))
+})
 
 var _ = SynchronizedAfterSuite(func() {}, func() {
 	err := os.Unsetenv("CERTSUITE_NON_INTRUSIVE_ONLY")
@@ -2,6 +2,7 @@ package parameters
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
 )
@@ -68,3 +69,12 @@ const (
 
 	SampleWorkloadImage = globalparameters.UBIMicroImage
 )
+
+// LaunchPolicies sets the certsuite run timeout and attempts of the lifecycle test cases.
+var LaunchPolicies = map[string]globalparameters.LaunchPolicy{
+	CertsuiteCrdScaling:               {Timeout: 45 * time.Minute},
+	CertsuiteDeploymentScalingTcName:  {Timeout: 45 * time.Minute},
+	CertsuiteStatefulSetScalingTcName: {Timeout: 45 * time.Minute},
+	// Pod recreation cordons and drains nodes, it is not retried on a cluster left half drained.
+	CertsuitePodRecreationTcName: {Timeout: time.Hour, MaxAttempts: 1},
+}
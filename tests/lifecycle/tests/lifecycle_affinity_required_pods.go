@@ -46,8 +46,7 @@ var _ = Describe("lifecycle-affinity-required-pods", Label("lifecycle1"), func()
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 55327
-	It("One pod, label is set, Affinity rules are set", func() {
+	It("One pod, label is set, Affinity rules are set", globalhelper.PolarionID("55327"), func() {
 		By("Define and create pod")
 		put := tshelper.DefinePod(tsparams.TestPodName, randomNamespace)
 		globalhelper.AppendLabelsToPod(put, tsparams.TestTargetLabels)
@@ -69,8 +68,7 @@ var _ = Describe("lifecycle-affinity-required-pods", Label("lifecycle1"), func()
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55328
-	It("Two pods, labels are set for both, Affinity rules are set", func() {
+	It("Two pods, labels are set for both, Affinity rules are set", globalhelper.PolarionID("55328"), func() {
 		By("Define and create pods")
 		putA := tshelper.DefinePod(tsparams.TestPodName, randomNamespace)
 		globalhelper.AppendLabelsToPod(putA, tsparams.TestTargetLabels)
@@ -101,8 +99,7 @@ var _ = Describe("lifecycle-affinity-required-pods", Label("lifecycle1"), func()
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55329
-	It("One pod, label is set, affinity rules are not set [negative]", func() {
+	It("One pod, label is set, affinity rules are not set [negative]", globalhelper.PolarionID("55329"), func() {
 		By("Define and create pod")
 		put := tshelper.DefinePod(tsparams.TestPodName, randomNamespace)
 		globalhelper.AppendLabelsToPod(put, tsparams.TestTargetLabels)
@@ -122,8 +119,7 @@ var _ = Describe("lifecycle-affinity-required-pods", Label("lifecycle1"), func()
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55330
-	It("One pod, label is set, podantiaffinity is set [negative]", func() {
+	It("One pod, label is set, podantiaffinity is set [negative]", globalhelper.PolarionID("55330"), func() {
 		put := tshelper.DefinePod(tsparams.TestPodName, randomNamespace)
 		globalhelper.AppendLabelsToPod(put, tsparams.TestTargetLabels)
 		globalhelper.AppendLabelsToPod(put, tsparams.AffinityRequiredPodLabels)
@@ -148,8 +144,8 @@ var _ = Describe("lifecycle-affinity-required-pods", Label("lifecycle1"), func()
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55333
-	It("Two pods, labels are set for both, affinity rules are not set for one of the pods [negative]", func() {
+	It("Two pods, labels are set for both, affinity rules are not set for one of the pods "+
+		"[negative]", globalhelper.PolarionID("55333"), func() {
 		By("Define and create pods")
 		putA := tshelper.DefinePod(tsparams.TestPodName, randomNamespace)
 		globalhelper.AppendLabelsToPod(putA, tsparams.TestTargetLabels)
@@ -39,8 +39,7 @@ var _ = Describe("lifecycle-container-prestop", Label("lifecycle1"), func() {
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 47311
-	It("One deployment, one pod with preStop field configured", func() {
+	It("One deployment, one pod with preStop field configured", globalhelper.PolarionID("47311"), func() {
 		By("Define deployment with preStop field configured")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -67,8 +66,7 @@ var _ = Describe("lifecycle-container-prestop", Label("lifecycle1"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 47315
-	It("One deployment, one pod without preStop field configured [negative]", func() {
+	It("One deployment, one pod without preStop field configured [negative]", globalhelper.PolarionID("47315"), func() {
 		By("Define deployment without prestop field configured")
 		deployment, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -93,8 +91,7 @@ var _ = Describe("lifecycle-container-prestop", Label("lifecycle1"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 47382
-	It("One deployment, several pods, several containers that have preStop field configured", func() {
+	It("One deployment, several pods, several containers that have preStop field configured", globalhelper.PolarionID("47382"), func() {
 		By("Define deployment with preStop field configured")
 		deploymenta, err := tshelper.DefineDeployment(3, 2, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -121,8 +118,7 @@ var _ = Describe("lifecycle-container-prestop", Label("lifecycle1"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 47383
-	It("Two deployments, several pods, several containers that have preStop field configured", func() {
+	It("Two deployments, several pods, several containers that have preStop field configured", globalhelper.PolarionID("47383"), func() {
 		By("Define first deployment with preStop field configured")
 		deploymenta, err := tshelper.DefineDeployment(3, 2, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -164,8 +160,8 @@ var _ = Describe("lifecycle-container-prestop", Label("lifecycle1"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 47384
-	It("One deployment, several pods, several containers one without preStop field configured [negative]", func() {
+	It("One deployment, several pods, several containers one without preStop field configured "+
+		"[negative]", globalhelper.PolarionID("47384"), func() {
 		By("Define deployment with preStop field configured")
 		deploymenta, err := tshelper.DefineDeployment(3, 2, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -188,8 +184,8 @@ var _ = Describe("lifecycle-container-prestop", Label("lifecycle1"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 50761
-	It("Two deployments, several pods, several containers that do not have preStop field configured [negative]", func() {
+	It("Two deployments, several pods, several containers that do not have preStop field configured "+
+		"[negative]", globalhelper.PolarionID("50761"), func() {
 		By("Define and create first deployment")
 		deploymenta, err := tshelper.DefineDeployment(3, 2, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
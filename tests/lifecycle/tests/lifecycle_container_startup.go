@@ -40,8 +40,7 @@ var _ = Describe("lifecycle-container-poststart", Label("lifecycle2"), func() {
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 55910
-	It("One deployment, one pod with postStart spec", func() {
+	It("One deployment, one pod with postStart spec", globalhelper.PolarionID("55910"), func() {
 		By("Define deployment with postStart spec")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -68,8 +67,7 @@ var _ = Describe("lifecycle-container-poststart", Label("lifecycle2"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55911
-	It("Two deployments, two containers each, all have postStart spec", func() {
+	It("Two deployments, two containers each, all have postStart spec", globalhelper.PolarionID("55911"), func() {
 		By("Define first deployment with postStart spec")
 		deploymenta, err := tshelper.DefineDeployment(1, 2, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -111,8 +109,7 @@ var _ = Describe("lifecycle-container-poststart", Label("lifecycle2"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55913
-	It("One statefulSet, one pod with postStart spec", func() {
+	It("One statefulSet, one pod with postStart spec", globalhelper.PolarionID("55913"), func() {
 		By("Define statefulSet with postStart spec")
 		statefulSet := tshelper.DefineStatefulSet(tsparams.TestStatefulSetName, randomNamespace)
 		statefulset.RedefineWithPostStart(statefulSet)
@@ -136,8 +133,7 @@ var _ = Describe("lifecycle-container-poststart", Label("lifecycle2"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55915
-	It("One pod with postStart spec", func() {
+	It("One pod with postStart spec", globalhelper.PolarionID("55915"), func() {
 		By("Define pod with postStart spec")
 		put := tshelper.DefinePod(tsparams.TestPodName, randomNamespace)
 		pod.RedefineWithPostStart(put)
@@ -162,8 +158,7 @@ var _ = Describe("lifecycle-container-poststart", Label("lifecycle2"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55916
-	It("One daemonSet without postStart spec [negative]", func() {
+	It("One daemonSet without postStart spec [negative]", globalhelper.PolarionID("55916"), func() {
 		By("Define daemonSet without postStart spec")
 		daemonSet := daemonset.DefineDaemonSet(randomNamespace,
 			tsparams.SampleWorkloadImage,
@@ -190,8 +185,7 @@ var _ = Describe("lifecycle-container-poststart", Label("lifecycle2"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55914
-	It("Two deployments, one pod each, one without postStart spec [negative]", func() {
+	It("Two deployments, one pod each, one without postStart spec [negative]", globalhelper.PolarionID("55914"), func() {
 		By("Define first deployment with postStart spec")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
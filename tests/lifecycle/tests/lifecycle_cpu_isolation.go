@@ -51,8 +51,7 @@ var _ = Describe("lifecycle-cpu-isolation", Serial, Label("lifecycle3", "ocp-req
 
 	const disableVar = "disable"
 
-	// 54723
-	It("One pod with conditions met", func() {
+	It("One pod with conditions met", globalhelper.PolarionID("54723"), func() {
 		annotationsMap := make(map[string]string)
 
 		By("Define pod with resources and runTimeClass")
@@ -91,8 +90,7 @@ var _ = Describe("lifecycle-cpu-isolation", Serial, Label("lifecycle3", "ocp-req
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54728
-	It("One pod two containers with conditions met", func() {
+	It("One pod two containers with conditions met", globalhelper.PolarionID("54728"), func() {
 		annotationsMap := make(map[string]string)
 
 		// Check if nodes in the cluster are overcommitted as far as resources
@@ -143,8 +141,7 @@ var _ = Describe("lifecycle-cpu-isolation", Serial, Label("lifecycle3", "ocp-req
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54732
-	It("One deployment one pod with conditions met", func() {
+	It("One deployment one pod with conditions met", globalhelper.PolarionID("54732"), func() {
 		annotationsMap := make(map[string]string)
 
 		By("Define deployment with resources and runTimeClass")
@@ -192,8 +189,7 @@ var _ = Describe("lifecycle-cpu-isolation", Serial, Label("lifecycle3", "ocp-req
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54733
-	It("One daemonSet with conditions met", func() {
+	It("One daemonSet with conditions met", globalhelper.PolarionID("54733"), func() {
 		annotationsMap := make(map[string]string)
 
 		By("Define daemonSet with resources and runTimeClass")
@@ -242,8 +238,7 @@ var _ = Describe("lifecycle-cpu-isolation", Serial, Label("lifecycle3", "ocp-req
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54734
-	It("One daemonSet no annotations [negative]", func() {
+	It("One daemonSet no annotations [negative]", globalhelper.PolarionID("54734"), func() {
 		By("Define daemonSet with resources and runTimeClass")
 		daemonSet := daemonset.DefineDaemonSet(randomNamespace, tsparams.SampleWorkloadImage,
 			tsparams.TestTargetLabels, tsparams.TestDaemonSetName)
@@ -276,8 +271,7 @@ var _ = Describe("lifecycle-cpu-isolation", Serial, Label("lifecycle3", "ocp-req
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54735
-	It("One deployment no runTimeClass [negative]", func() {
+	It("One deployment no runTimeClass [negative]", globalhelper.PolarionID("54735"), func() {
 		annotationsMap := make(map[string]string)
 
 		By("Define deployment with resources and no runTimeClass")
@@ -310,8 +304,7 @@ var _ = Describe("lifecycle-cpu-isolation", Serial, Label("lifecycle3", "ocp-req
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54737
-	It("Two pods one with conditions met, other lacks runTimeClass [negative]", func() {
+	It("Two pods one with conditions met, other lacks runTimeClass [negative]", globalhelper.PolarionID("54737"), func() {
 		annotationsMap := make(map[string]string)
 
 		// Check if nodes in the cluster are overcommitted as far as resources
@@ -39,12 +39,11 @@ var _ = Describe("lifecycle-crd-scaling", Serial, Label("lifecycle2", "ocp-requi
 			globalhelper.BeforeEachSetupWithRandomNamespace(tsparams.LifecycleNamespace)
 
 		By("Define certsuite config file")
-		err := globalhelper.DefineCertsuiteConfig(
-			[]string{randomNamespace},
-			[]string{tsparams.TestPodLabel},
-			[]string{tsparams.CertsuiteTargetOperatorLabels},
-			[]string{},
-			[]string{tsparams.CertsuiteTargetCrdFilters}, randomCertsuiteConfigDir)
+		err := globalhelper.NewCertsuiteConfig(randomNamespace).
+			WithPodLabels(tsparams.TestPodLabel).
+			WithOperatorLabels(tsparams.CertsuiteTargetOperatorLabels).
+			WithCrdFilter(tsparams.CertsuiteTargetCrdFilters, true).
+			Write(randomCertsuiteConfigDir)
 		Expect(err).ToNot(HaveOccurred(), "error defining certsuite config file")
 
 		if globalhelper.GetConfiguration().General.DisableIntrusiveTests == strings.ToLower("true") {
@@ -53,8 +53,7 @@ var _ = Describe("lifecycle-deployment-scaling", Serial, Label("lifecycle2"), fu
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 47398
-	It("One deployment, one pod, one container, scale in and out", func() {
+	It("One deployment, one pod, one container, scale in and out", globalhelper.PolarionID("47398"), func() {
 		if globalhelper.IsCRCCluster() {
 			Skip("Deployment scaling test is not supported on CRC clusters")
 		}
@@ -40,8 +40,7 @@ var _ = Describe("lifecycle-image-pull-policy", Label("lifecycle4"), func() {
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 48473
-	It("One deployment with ifNotPresent as ImagePullPolicy", func() {
+	It("One deployment with ifNotPresent as ImagePullPolicy", globalhelper.PolarionID("48473"), func() {
 		By("Define deployment with ifNotPresent as ImagePullPolicy")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -68,8 +67,7 @@ var _ = Describe("lifecycle-image-pull-policy", Label("lifecycle4"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48474
-	It("Several deployments with ifNotPresent as ImagePullPolicy", func() {
+	It("Several deployments with ifNotPresent as ImagePullPolicy", globalhelper.PolarionID("48474"), func() {
 		By("Define deployments with ifNotPresent as ImagePullPolicy")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -124,8 +122,7 @@ var _ = Describe("lifecycle-image-pull-policy", Label("lifecycle4"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48478
-	It("One DaemonSet with ifNotPresent as ImagePullPolicy", func() {
+	It("One DaemonSet with ifNotPresent as ImagePullPolicy", globalhelper.PolarionID("48478"), func() {
 		By("Define DaemonSet with ifNotPresent as ImagePullPolicy")
 		daemonSet := tshelper.DefineDaemonSetWithImagePullPolicy(tsparams.TestDaemonSetName,
 			randomNamespace, tsparams.SampleWorkloadImage, corev1.PullIfNotPresent)
@@ -150,8 +147,7 @@ var _ = Describe("lifecycle-image-pull-policy", Label("lifecycle4"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48479
-	It("Several DaemonSets with ifNotPresent as ImagePullPolicy", func() {
+	It("Several DaemonSets with ifNotPresent as ImagePullPolicy", globalhelper.PolarionID("48479"), func() {
 		By("Define DaemonSets with ifNotPresent as ImagePullPolicy")
 		daemonSeta := tshelper.DefineDaemonSetWithImagePullPolicy(tsparams.TestDaemonSetName,
 			randomNamespace, tsparams.SampleWorkloadImage, corev1.PullIfNotPresent)
@@ -197,8 +193,7 @@ var _ = Describe("lifecycle-image-pull-policy", Label("lifecycle4"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48480
-	It("One DaemonSet without ImagePullPolicy, image tag is not specified [negative]", func() {
+	It("One DaemonSet without ImagePullPolicy, image tag is not specified [negative]", globalhelper.PolarionID("48480"), func() {
 		// if you omit the imagePullPolicy field,
 		// and you do not specify the tag for the container image,
 		// imagePullPolicy is automatically set to Always;
@@ -226,8 +221,7 @@ var _ = Describe("lifecycle-image-pull-policy", Label("lifecycle4"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48481
-	It("One deployment without ImagePullPolicy, image tag is latest [negative]", func() {
+	It("One deployment without ImagePullPolicy, image tag is latest [negative]", globalhelper.PolarionID("48481"), func() {
 		// if you omit the imagePullPolicy field,
 		// and the tag for the container image is :latest,
 		// imagePullPolicy is automatically set to Always;
@@ -255,8 +249,7 @@ var _ = Describe("lifecycle-image-pull-policy", Label("lifecycle4"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48482
-	It("One deployment with Always as ImagePullPolicy [negative]", func() {
+	It("One deployment with Always as ImagePullPolicy [negative]", globalhelper.PolarionID("48482"), func() {
 		By("Define deployment with 'Always' as ImagePullPolicy")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -283,8 +276,7 @@ var _ = Describe("lifecycle-image-pull-policy", Label("lifecycle4"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48484
-	It("Two deployments one with Never other with ifNotPresent as ImagePullPolicy [negative]", func() {
+	It("Two deployments one with Never other with ifNotPresent as ImagePullPolicy [negative]", globalhelper.PolarionID("48484"), func() {
 		By("Define deployment with Never as ImagePullPolicy")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -326,8 +318,8 @@ var _ = Describe("lifecycle-image-pull-policy", Label("lifecycle4"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48485
-	It("One DaemonSet with Never one deployment with ifNotPresent as ImagePullPolicy [negative]", func() {
+	It("One DaemonSet with Never one deployment with ifNotPresent as ImagePullPolicy "+
+		"[negative]", globalhelper.PolarionID("48485"), func() {
 		By("Define DaemonSet with Never as ImagePullPolicy")
 		daemonSet := tshelper.DefineDaemonSetWithImagePullPolicy(tsparams.TestDaemonSetName,
 			randomNamespace, tsparams.SampleWorkloadImage, corev1.PullNever)
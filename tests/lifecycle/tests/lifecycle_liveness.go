@@ -42,8 +42,7 @@ var _ = Describe("lifecycle-liveness", Label("lifecycle5"), func() {
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 50053
-	It("One deployment, one pod with a liveness probe", func() {
+	It("One deployment, one pod with a liveness probe", globalhelper.PolarionID("50053"), func() {
 		By("Define deployment with a liveness probe")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -69,8 +68,7 @@ var _ = Describe("lifecycle-liveness", Label("lifecycle5"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 50054
-	It("Two deployments, multiple pods each, all have a liveness probe", func() {
+	It("Two deployments, multiple pods each, all have a liveness probe", globalhelper.PolarionID("50054"), func() {
 		By("Define first deployment with a liveness probe")
 		deploymenta, err := tshelper.DefineDeployment(3, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -117,8 +115,7 @@ var _ = Describe("lifecycle-liveness", Label("lifecycle5"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 50055
-	It("One statefulSet, one pod with a liveness probe", func() {
+	It("One statefulSet, one pod with a liveness probe", globalhelper.PolarionID("50055"), func() {
 		By("Define statefulSet with a liveness probe")
 		statefulSet := tshelper.DefineStatefulSet(tsparams.TestStatefulSetName, randomNamespace)
 		statefulset.RedefineWithLivenessProbe(statefulSet)
@@ -141,8 +138,7 @@ var _ = Describe("lifecycle-liveness", Label("lifecycle5"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 50056
-	It("One pod with a liveness probe", func() {
+	It("One pod with a liveness probe", globalhelper.PolarionID("50056"), func() {
 		By("Define pod with a liveness probe")
 		put := tshelper.DefinePod(tsparams.TestPodName, randomNamespace)
 		pod.RedefineWithLivenessProbe(put)
@@ -160,8 +156,7 @@ var _ = Describe("lifecycle-liveness", Label("lifecycle5"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 50057
-	It("One daemonSet without a liveness probe [negative]", func() {
+	It("One daemonSet without a liveness probe [negative]", globalhelper.PolarionID("50057"), func() {
 		By("Define daemonSet without a liveness probe")
 		daemonSet := daemonset.DefineDaemonSet(randomNamespace,
 			tsparams.SampleWorkloadImage,
@@ -186,8 +181,7 @@ var _ = Describe("lifecycle-liveness", Label("lifecycle5"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 50058
-	It("Two deployments, one pod each, one without a liveness probe [negative]", func() {
+	It("Two deployments, one pod each, one without a liveness probe [negative]", globalhelper.PolarionID("50058"), func() {
 		By("Define first deployment with a liveness probe")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -44,8 +44,7 @@ var _ = Describe("lifecycle-persistent-volume-reclaim-policy", Serial, Label("li
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 54201
-	It("One deployment, one pod with a volume that uses a reclaim policy of delete", func() {
+	It("One deployment, one pod with a volume that uses a reclaim policy of delete", globalhelper.PolarionID("54201"), func() {
 		pvc := persistentvolumeclaim.DefinePersistentVolumeClaim(tsparams.TestPVCName, randomNamespace)
 		persistentVolume := persistentvolume.DefinePersistentVolume(randomPV, pvc.Name, pvc.Namespace)
 		persistentvolume.RedefineWithPVReclaimPolicy(persistentVolume, corev1.PersistentVolumeReclaimDelete)
@@ -94,8 +93,7 @@ var _ = Describe("lifecycle-persistent-volume-reclaim-policy", Serial, Label("li
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54202
-	It("One pod with a volume that uses a reclaim policy of delete", func() {
+	It("One pod with a volume that uses a reclaim policy of delete", globalhelper.PolarionID("54202"), func() {
 		pvc := persistentvolumeclaim.DefinePersistentVolumeClaim(tsparams.TestPVCName, randomNamespace)
 		persistentVolume := persistentvolume.DefinePersistentVolume(randomPV, pvc.Name, pvc.Namespace)
 		persistentvolume.RedefineWithPVReclaimPolicy(persistentVolume, corev1.PersistentVolumeReclaimDelete)
@@ -135,8 +133,7 @@ var _ = Describe("lifecycle-persistent-volume-reclaim-policy", Serial, Label("li
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54203
-	It("One replicaSet with a volume that uses a reclaim policy of delete", func() {
+	It("One replicaSet with a volume that uses a reclaim policy of delete", globalhelper.PolarionID("54203"), func() {
 		pvc := persistentvolumeclaim.DefinePersistentVolumeClaim(tsparams.TestPVCName, randomNamespace)
 		persistentVolume := persistentvolume.DefinePersistentVolume(randomPV, pvc.Name, pvc.Namespace)
 		persistentvolume.RedefineWithPVReclaimPolicy(persistentVolume, corev1.PersistentVolumeReclaimDelete)
@@ -176,8 +173,7 @@ var _ = Describe("lifecycle-persistent-volume-reclaim-policy", Serial, Label("li
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54204
-	It("One deployment, one pod with a volume that uses a reclaim policy of retain [negative]", func() {
+	It("One deployment, one pod with a volume that uses a reclaim policy of retain [negative]", globalhelper.PolarionID("54204"), func() {
 		pvc := persistentvolumeclaim.DefinePersistentVolumeClaim(tsparams.TestPVCName, randomNamespace)
 		persistentVolume := persistentvolume.DefinePersistentVolume(randomPV, pvc.Name, pvc.Namespace)
 		persistentvolume.RedefineWithPVReclaimPolicy(persistentVolume, corev1.PersistentVolumeReclaimRetain)
@@ -224,8 +220,7 @@ var _ = Describe("lifecycle-persistent-volume-reclaim-policy", Serial, Label("li
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54206
-	It("One pod with a volume that uses a reclaim policy of recycle [negative]", func() {
+	It("One pod with a volume that uses a reclaim policy of recycle [negative]", globalhelper.PolarionID("54206"), func() {
 		pvc := persistentvolumeclaim.DefinePersistentVolumeClaim(tsparams.TestPVCName, randomNamespace)
 		persistentVolume := persistentvolume.DefinePersistentVolume(randomPV, pvc.Name, pvc.Namespace)
 		persistentvolume.RedefineWithPVReclaimPolicy(persistentVolume, corev1.PersistentVolumeReclaimRecycle)
@@ -265,8 +260,7 @@ var _ = Describe("lifecycle-persistent-volume-reclaim-policy", Serial, Label("li
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54207
-	It("Two deployments, one with reclaim policy of delete, other with recycle [negative]", func() {
+	It("Two deployments, one with reclaim policy of delete, other with recycle [negative]", globalhelper.PolarionID("54207"), func() {
 		By("Define and create first pv")
 		pvca := persistentvolumeclaim.DefinePersistentVolumeClaim(tsparams.TestPVCName, randomNamespace)
 		persistentVolumea := persistentvolume.DefinePersistentVolume(randomPV, pvca.Name, pvca.Namespace)
@@ -46,8 +46,7 @@ var _ = Describe("lifecycle-pod-high-availability", Serial, Label("lifecycle5",
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 48492
-	It("One deployment, replicas are more than 1, podAntiAffinity is set", func() {
+	It("One deployment, replicas are more than 1, podAntiAffinity is set", globalhelper.PolarionID("48492"), func() {
 		schedulableNodes, err := nodes.GetNumOfReadyNodesInCluster(globalhelper.GetAPIClient().Nodes())
 		Expect(err).ToNot(HaveOccurred())
 
@@ -81,8 +80,7 @@ var _ = Describe("lifecycle-pod-high-availability", Serial, Label("lifecycle5",
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48495
-	It("Two deployments, replicas are more than 1, podAntiAffinity is set", func() {
+	It("Two deployments, replicas are more than 1, podAntiAffinity is set", globalhelper.PolarionID("48495"), func() {
 		schedulableNodes, err := nodes.GetNumOfReadyNodesInCluster(globalhelper.GetAPIClient().Nodes())
 		Expect(err).ToNot(HaveOccurred())
 
@@ -131,8 +129,7 @@ var _ = Describe("lifecycle-pod-high-availability", Serial, Label("lifecycle5",
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48499
-	It("One deployment, replicas are more than 1, podAntiAffinity is not set [negative]", func() {
+	It("One deployment, replicas are more than 1, podAntiAffinity is not set [negative]", globalhelper.PolarionID("48499"), func() {
 		schedulableNodes, err := nodes.GetNumOfReadyNodesInCluster(globalhelper.GetAPIClient().Nodes())
 		Expect(err).ToNot(HaveOccurred())
 
@@ -164,8 +161,7 @@ var _ = Describe("lifecycle-pod-high-availability", Serial, Label("lifecycle5",
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48500
-	It("Two deployments, replicas are more than 1, podAntiAffinity is not set [negative]", func() {
+	It("Two deployments, replicas are more than 1, podAntiAffinity is not set [negative]", globalhelper.PolarionID("48500"), func() {
 		schedulableNodes, err := nodes.GetNumOfReadyNodesInCluster(globalhelper.GetAPIClient().Nodes())
 		Expect(err).ToNot(HaveOccurred())
 
@@ -208,8 +204,7 @@ var _ = Describe("lifecycle-pod-high-availability", Serial, Label("lifecycle5",
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48869
-	It("One deployment, replicas equal to 1, podAntiAffinity is set [negative]", func() {
+	It("One deployment, replicas equal to 1, podAntiAffinity is set [negative]", globalhelper.PolarionID("48869"), func() {
 		schedulableNodes, err := nodes.GetNumOfReadyNodesInCluster(globalhelper.GetAPIClient().Nodes())
 		Expect(err).ToNot(HaveOccurred())
 
@@ -39,8 +39,7 @@ var _ = Describe("lifecycle-pod-owner-type", Label("lifecycle6"), func() {
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 47409
-	It("One ReplicaSet, several pods", func() {
+	It("One ReplicaSet, several pods", globalhelper.PolarionID("47409"), func() {
 		By("Define ReplicaSet with replica number")
 		replicaSet := tshelper.DefineReplicaSet(tsparams.TestReplicaSetName, randomNamespace)
 		replicaset.RedefineWithReplicaNumber(replicaSet, 3)
@@ -63,8 +62,7 @@ var _ = Describe("lifecycle-pod-owner-type", Label("lifecycle6"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 47424
-	It("Two deployments, several pods", func() {
+	It("Two deployments, several pods", globalhelper.PolarionID("47424"), func() {
 		By("Define deployments")
 		deploymenta, err := tshelper.DefineDeployment(2, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -95,8 +93,7 @@ var _ = Describe("lifecycle-pod-owner-type", Label("lifecycle6"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 47426
-	It("StatefulSet pod", func() {
+	It("StatefulSet pod", globalhelper.PolarionID("47426"), func() {
 		By("Define statefulSet")
 		statefulSet := tshelper.DefineStatefulSet(tsparams.TestStatefulSetName, randomNamespace)
 
@@ -118,8 +115,7 @@ var _ = Describe("lifecycle-pod-owner-type", Label("lifecycle6"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 47429
-	It("One pod, not part of any workload resource [negative]", func() {
+	It("One pod, not part of any workload resource [negative]", globalhelper.PolarionID("47429"), func() {
 		By("Define pod")
 		put := tshelper.DefinePod(tsparams.TestPodName, randomNamespace)
 
@@ -142,8 +138,7 @@ var _ = Describe("lifecycle-pod-owner-type", Label("lifecycle6"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 47430
-	It("Two deployments, one pod not related to any resource [negative]", func() {
+	It("Two deployments, one pod not related to any resource [negative]", globalhelper.PolarionID("47430"), func() {
 		By("Define deployments")
 		deploymenta, err := tshelper.DefineDeployment(2, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -61,8 +61,7 @@ var _ = Describe("lifecycle-pod-recreation", Serial, Label("lifecycle7", "ocp-re
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 47405
-	It("One deployment with PodAntiAffinity, replicas are less than schedulable nodes", func() {
+	It("One deployment with PodAntiAffinity, replicas are less than schedulable nodes", globalhelper.PolarionID("47405"), func() {
 		if globalhelper.IsCRCCluster() {
 			Skip("PodAntiAffinity test is not supported on CRC clusters")
 		}
@@ -101,8 +100,7 @@ var _ = Describe("lifecycle-pod-recreation", Serial, Label("lifecycle7", "ocp-re
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 47406
-	It("Two deployments with PodAntiAffinity, replicas are less than schedulable nodes", func() {
+	It("Two deployments with PodAntiAffinity, replicas are less than schedulable nodes", globalhelper.PolarionID("47406"), func() {
 		schedulableNodes, err := nodes.GetNumOfReadyNodesInCluster(globalhelper.GetAPIClient().Nodes())
 		Expect(err).ToNot(HaveOccurred())
 
@@ -152,8 +150,8 @@ var _ = Describe("lifecycle-pod-recreation", Serial, Label("lifecycle7", "ocp-re
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 47407
-	It("One deployment with PodAntiAffinity, replicas are equal to schedulable nodes [negative]", func() {
+	It("One deployment with PodAntiAffinity, replicas are equal to schedulable nodes "+
+		"[negative]", globalhelper.PolarionID("47407"), func() {
 		if globalhelper.IsCRCCluster() {
 			Skip("PodAntiAffinity test is not supported on CRC clusters")
 		}
@@ -190,8 +188,8 @@ var _ = Describe("lifecycle-pod-recreation", Serial, Label("lifecycle7", "ocp-re
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 47408
-	It("Two deployments with PodAntiAffinity, replicas are equal to schedulable nodes [negative]", func() {
+	It("Two deployments with PodAntiAffinity, replicas are equal to schedulable nodes "+
+		"[negative]", globalhelper.PolarionID("47408"), func() {
 		schedulableNodes, err := nodes.GetNumOfReadyNodesInCluster(globalhelper.GetAPIClient().Nodes())
 		Expect(err).ToNot(HaveOccurred())
 
@@ -47,8 +47,7 @@ var _ = Describe("lifecycle-pod-scheduling", Label("lifecycle7"), func() {
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 48120
-	It("One deployment, no nodeSelector nor nodeAffinity", func() {
+	It("One deployment, no nodeSelector nor nodeAffinity", globalhelper.PolarionID("48120"), func() {
 		By("Define Deployment")
 		deployment, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -81,8 +80,7 @@ var _ = Describe("lifecycle-pod-scheduling", Label("lifecycle7"), func() {
 		}
 	})
 
-	// 48458
-	It("One deployment with nodeSelector [negative]", func() {
+	It("One deployment with nodeSelector [negative]", globalhelper.PolarionID("48458"), func() {
 		By("Define Deployment with nodeSelector")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -118,8 +116,7 @@ var _ = Describe("lifecycle-pod-scheduling", Label("lifecycle7"), func() {
 		}
 	})
 
-	// 48470
-	It("One deployment with nodeAffinity [negative]", func() {
+	It("One deployment with nodeAffinity [negative]", globalhelper.PolarionID("48470"), func() {
 		By("Define Deployment with nodeAffinity")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -154,8 +151,7 @@ var _ = Describe("lifecycle-pod-scheduling", Label("lifecycle7"), func() {
 		}
 	})
 
-	// 48471
-	It("Two deployments, one pod each, one pod with nodeAffinity [negative]", func() {
+	It("Two deployments, one pod each, one pod with nodeAffinity [negative]", globalhelper.PolarionID("48471"), func() {
 		By("Define Deployment without nodeAffinity")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -203,8 +199,7 @@ var _ = Describe("lifecycle-pod-scheduling", Label("lifecycle7"), func() {
 		}
 	})
 
-	// 48472
-	It("One deployment, one daemonSet [negative]", func() {
+	It("One deployment, one daemonSet [negative]", globalhelper.PolarionID("48472"), func() {
 		By("Define Deployment without nodeAffinity/ nodeSelector")
 		deployment, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -38,8 +38,7 @@ var _ = Describe("Lifecycle pod-toleration-bypass", Label("lifecycle8"), func()
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 54984
-	It("one deployment, one pod, no tolerations modified", func() {
+	It("one deployment, one pod, no tolerations modified", globalhelper.PolarionID("54984"), func() {
 		By("Define deployment with no tolerations modified")
 		dep, err := tshelper.DefineDeploymentWithoutInfrastructureTolerations(1, 1, "lifecycledeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -61,8 +60,7 @@ var _ = Describe("Lifecycle pod-toleration-bypass", Label("lifecycle8"), func()
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54987
-	It("one deployment, one pod, NoExecute toleration modified [negative]", func() {
+	It("one deployment, one pod, NoExecute toleration modified [negative]", globalhelper.PolarionID("54987"), func() {
 		By("Define deployment with NoExecute toleration modified")
 		dep, err := tshelper.DefineDeployment(1, 1, "lifecycledeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -86,8 +84,7 @@ var _ = Describe("Lifecycle pod-toleration-bypass", Label("lifecycle8"), func()
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54988
-	It("one deployment, one pod, PreferNoSchedule toleration modified [negative]", func() {
+	It("one deployment, one pod, PreferNoSchedule toleration modified [negative]", globalhelper.PolarionID("54988"), func() {
 		By("Define deployment with PreferNoSchedule toleration modified")
 		dep, err := tshelper.DefineDeployment(1, 1, "lifecycledeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -111,8 +108,7 @@ var _ = Describe("Lifecycle pod-toleration-bypass", Label("lifecycle8"), func()
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54989
-	It("one deployment, one pod, NoSchedule toleration modified [negative]", func() {
+	It("one deployment, one pod, NoSchedule toleration modified [negative]", globalhelper.PolarionID("54989"), func() {
 		By("Define deployment with NoSchedule toleration modified")
 		dep, err := tshelper.DefineDeployment(1, 1, "lifecycledeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -136,8 +132,7 @@ var _ = Describe("Lifecycle pod-toleration-bypass", Label("lifecycle8"), func()
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54990
-	It("two deployments, one pod each, no tolerations modified", func() {
+	It("two deployments, one pod each, no tolerations modified", globalhelper.PolarionID("54990"), func() {
 		By("Define deployments with no tolerations modified")
 		dep, err := tshelper.DefineDeploymentWithoutInfrastructureTolerations(1, 1, "lifecycledeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -166,8 +161,7 @@ var _ = Describe("Lifecycle pod-toleration-bypass", Label("lifecycle8"), func()
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54991
-	It("two deployments, one pod each, NoExecute and NoSchedule modified [negative]", func() {
+	It("two deployments, one pod each, NoExecute and NoSchedule modified [negative]", globalhelper.PolarionID("54991"), func() {
 		By("Define deployments with NoExecute and NoSchedule modified for one")
 		dep, err := tshelper.DefineDeployment(1, 1, "lifecycledeployment", randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -42,8 +42,7 @@ var _ = Describe("lifecycle-readiness", Label("lifecycle8"), func() {
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 50145
-	It("One deployment, one pod with a readiness probe", func() {
+	It("One deployment, one pod with a readiness probe", globalhelper.PolarionID("50145"), func() {
 		By("Define deployment with a readiness probe")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -69,8 +68,7 @@ var _ = Describe("lifecycle-readiness", Label("lifecycle8"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 50146
-	It("Two deployments, multiple pods each, all have a readiness probe", func() {
+	It("Two deployments, multiple pods each, all have a readiness probe", globalhelper.PolarionID("50146"), func() {
 		By("Define first deployment with a readiness probe")
 		deploymenta, err := tshelper.DefineDeployment(3, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -111,8 +109,7 @@ var _ = Describe("lifecycle-readiness", Label("lifecycle8"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 50147
-	It("One statefulSet, one pod with a readiness probe", func() {
+	It("One statefulSet, one pod with a readiness probe", globalhelper.PolarionID("50147"), func() {
 		By("Define statefulSet with a readiness probe")
 		statefulSet := tshelper.DefineStatefulSet(tsparams.TestStatefulSetName, randomNamespace)
 		statefulset.RedefineWithReadinessProbe(statefulSet)
@@ -134,8 +131,7 @@ var _ = Describe("lifecycle-readiness", Label("lifecycle8"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 50148
-	It("One pod with a readiness probe", func() {
+	It("One pod with a readiness probe", globalhelper.PolarionID("50148"), func() {
 		By("Define pod with a readiness probe")
 		put := tshelper.DefinePod(tsparams.TestPodName, randomNamespace)
 		pod.RedefineWithReadinessProbe(put)
@@ -158,8 +154,7 @@ var _ = Describe("lifecycle-readiness", Label("lifecycle8"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 50149
-	It("One daemonSet without a readiness probe [negative]", func() {
+	It("One daemonSet without a readiness probe [negative]", globalhelper.PolarionID("50149"), func() {
 		By("Define daemonSet without a readiness probe")
 		daemonSet := daemonset.DefineDaemonSet(randomNamespace,
 			tsparams.SampleWorkloadImage, tsparams.TestTargetLabels, tsparams.TestDaemonSetName)
@@ -183,8 +178,7 @@ var _ = Describe("lifecycle-readiness", Label("lifecycle8"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 50150
-	It("Two deployments, one pod each, one without a readiness probe [negative]", func() {
+	It("Two deployments, one pod each, one without a readiness probe [negative]", globalhelper.PolarionID("50150"), func() {
 		By("Define first deployment with a readiness probe")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
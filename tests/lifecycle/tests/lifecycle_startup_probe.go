@@ -42,8 +42,7 @@ var _ = Describe("lifecycle-startup-probe", Label("lifecycle9"), func() {
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 54808
-	It("One deployment, one pod with a startup probe", func() {
+	It("One deployment, one pod with a startup probe", globalhelper.PolarionID("54808"), func() {
 		By("Define deployment with a startup probe")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -69,8 +68,7 @@ var _ = Describe("lifecycle-startup-probe", Label("lifecycle9"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54809
-	It("Two deployments, multiple pods each, all have a startup probe", func() {
+	It("Two deployments, multiple pods each, all have a startup probe", globalhelper.PolarionID("54809"), func() {
 		By("Define first deployment with a startup probe")
 		deploymenta, err := tshelper.DefineDeployment(3, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -111,8 +109,7 @@ var _ = Describe("lifecycle-startup-probe", Label("lifecycle9"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54810
-	It("One statefulSet, one pod with a startup probe", func() {
+	It("One statefulSet, one pod with a startup probe", globalhelper.PolarionID("54810"), func() {
 		By("Define statefulSet with a startup probe")
 		statefulSet := tshelper.DefineStatefulSet(tsparams.TestStatefulSetName, randomNamespace)
 		statefulset.RedefineWithStartUpProbe(statefulSet)
@@ -135,8 +132,7 @@ var _ = Describe("lifecycle-startup-probe", Label("lifecycle9"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54811
-	It("One pod with a startup probe", func() {
+	It("One pod with a startup probe", globalhelper.PolarionID("54811"), func() {
 		By("Define pod with a startup probe")
 		put := tshelper.DefinePod(tsparams.TestPodName, randomNamespace)
 		pod.RedefineWithStartUpProbe(put)
@@ -154,8 +150,7 @@ var _ = Describe("lifecycle-startup-probe", Label("lifecycle9"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54814
-	It("One deployment two containers with a startup probe", func() {
+	It("One deployment two containers with a startup probe", globalhelper.PolarionID("54814"), func() {
 		By("Define deployment with a startup probe")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -182,8 +177,7 @@ var _ = Describe("lifecycle-startup-probe", Label("lifecycle9"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54812
-	It("One daemonSet without a startup probe [negative]", func() {
+	It("One daemonSet without a startup probe [negative]", globalhelper.PolarionID("54812"), func() {
 		By("Define daemonSet without a startup probe")
 		daemonSet := daemonset.DefineDaemonSet(randomNamespace,
 			tsparams.SampleWorkloadImage,
@@ -203,8 +197,7 @@ var _ = Describe("lifecycle-startup-probe", Label("lifecycle9"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54813
-	It("Two deployments, one pod each, one without a startup probe [negative]", func() {
+	It("Two deployments, one pod each, one without a startup probe [negative]", globalhelper.PolarionID("54813"), func() {
 		By("Define first deployment with a startup probe")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -243,8 +236,7 @@ var _ = Describe("lifecycle-startup-probe", Label("lifecycle9"), func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 54815
-	It("One deployment two containers one has a startup probe, other does not [negative]", func() {
+	It("One deployment two containers one has a startup probe, other does not [negative]", globalhelper.PolarionID("54815"), func() {
 		By("Define deployment with a startup probe")
 		deploymenta, err := tshelper.DefineDeployment(1, 1, tsparams.TestDeploymentName, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -60,8 +60,7 @@ var _ = Describe("lifecycle-statefulset-scaling", Serial, Label("lifecycle3", "o
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 45439
-	It("One statefulSet, one pod", func() {
+	It("One statefulSet, one pod", globalhelper.PolarionID("45439"), func() {
 		if globalhelper.IsCRCCluster() {
 			Skip("StatefulSet scaling test is not supported on CRC clusters")
 		}
@@ -2,6 +2,7 @@ package parameters
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
 )
@@ -33,3 +34,8 @@ const (
 
 	SampleWorkloadImage = globalparameters.UBIMicroImage
 )
+
+// LaunchPolicies sets the certsuite run timeout and attempts of the manageability test cases.
+var LaunchPolicies = map[string]globalparameters.LaunchPolicy{
+	globalparameters.ManageabilitySuiteName: {Timeout: 10 * time.Minute},
+}
@@ -20,10 +20,11 @@ import (
 )
 
 func TestNetworking(t *testing.T) {
+	globalhelper.RegisterLaunchPolicies(tsparams.LaunchPolicies)
 	globalhelper.RunSuite(t, "CNFCert networking tests")
 }
 
-var _ = SynchronizedBeforeSuite(func() {
+var _ = SynchronizedBeforeSuite(func() []byte {
 	configSuite, err := config.NewConfig()
 	if err != nil {
 		klog.Fatalf("can not load config file: %v", err)
@@ -48,4 +49,15 @@ var _ = SynchronizedBeforeSuite(func() {
 	By("Ensure all nodes are labeled with 'worker-cnf' label")
 	err = nodes.EnsureAllNodesAreLabeled(configSuite.General.CnfNodeLabel)
 	Expect(err).ToNot(HaveOccurred())
-}, func() {})
+
+	By("Check certsuite image")
+	Expect(globalhelper.ValidateCertsuiteImage()).To(Succeed(), "Error checking certsuite image")
+
+	By("Build certsuite binary")
+	certsuiteBinary, err := globalhelper.BuildCertsuiteBinary()
+	Expect(err).ToNot(HaveOccurred(), "Error building certsuite binary")
+
+	return []byte(certsuiteBinary)
+}, func(certsuiteBinary []byte) {
+	globalhelper.SetCertsuiteBinary(string(certsuiteBinary))
+})
@@ -2,6 +2,7 @@ package parameters
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
 )
@@ -77,3 +78,8 @@ const (
 
 	SampleWorkloadImage = globalparameters.UBIMicroImage
 )
+
+// LaunchPolicies sets the certsuite run timeout and attempts of the networking test cases.
+var LaunchPolicies = map[string]globalparameters.LaunchPolicy{
+	globalparameters.NetworkSuiteName: {Timeout: 20 * time.Minute},
+}
@@ -45,8 +45,7 @@ var _ = Describe("Networking custom namespace, custom deployment,", Serial, Labe
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 45440
-	It("3 custom pods on Default network networking-icmpv4-connectivity", func() {
+	It("3 custom pods on Default network networking-icmpv4-connectivity", globalhelper.PolarionID("45440"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentOnCluster(3, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -69,8 +68,7 @@ var _ = Describe("Networking custom namespace, custom deployment,", Serial, Labe
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 45441
-	It("custom daemonset, 4 custom pods on Default network", func() {
+	It("custom daemonset, 4 custom pods on Default network", globalhelper.PolarionID("45441"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentOnCluster(2, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -107,9 +105,8 @@ var _ = Describe("Networking custom namespace, custom deployment,", Serial, Labe
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 45442
 	It("3 custom pods on Default network networking-icmpv4-connectivity fail when "+
-		"one pod is disconnected [negative]", func() {
+		"one pod is disconnected [negative]", globalhelper.PolarionID("45442"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreatePrivilegedDeploymentOnCluster(2, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -142,9 +139,8 @@ var _ = Describe("Networking custom namespace, custom deployment,", Serial, Labe
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 45444
 	It("2 custom pods on Default network networking-icmpv4-connectivity skip when label "+
-		"redhat-best-practices-for-k8s.com/skip_connectivity_tests is set in deployment [skip]", func() {
+		"redhat-best-practices-for-k8s.com/skip_connectivity_tests is set in deployment [skip]", globalhelper.PolarionID("45444"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentWithSkippedLabelOnCluster(2, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -172,9 +168,8 @@ var _ = Describe("Networking custom namespace, custom deployment,", Serial, Labe
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 45445
 	It("custom daemonset, 4 custom pods on Default network networking-icmpv4-connectivity pass when label "+
-		"redhat-best-practices-for-k8s.com/skip_connectivity_tests is set in deployment only", func() {
+		"redhat-best-practices-for-k8s.com/skip_connectivity_tests is set in deployment only", globalhelper.PolarionID("45445"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentWithSkippedLabelOnCluster(2, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
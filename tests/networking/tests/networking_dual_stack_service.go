@@ -38,8 +38,7 @@ var _ = Describe("Networking dual-stack-service,", Serial, Label("networking1"),
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 62506
-	It("service with ipFamilyPolicy SingleStack and ip version ipv4 [negative]", func() {
+	It("service with ipFamilyPolicy SingleStack and ip version ipv4 [negative]", globalhelper.PolarionID("62506"), func() {
 		By("Define and create service")
 		err := tshelper.DefineAndCreateServiceOnCluster("testservice", randomNamespace, 3022, 3022, false, false,
 			[]corev1.IPFamily{"IPv4"}, "SingleStack")
@@ -58,8 +57,7 @@ var _ = Describe("Networking dual-stack-service,", Serial, Label("networking1"),
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 62507
-	It("service with ipFamilyPolicy PreferDualStack and zero ClusterIPs [negative]", func() {
+	It("service with ipFamilyPolicy PreferDualStack and zero ClusterIPs [negative]", globalhelper.PolarionID("62507"), func() {
 		By("Define and create service")
 		err := tshelper.DefineAndCreateServiceOnCluster("testservice", randomNamespace, 3023, 3023,
 			false, true, []corev1.IPFamily{"IPv4"}, "PreferDualStack")
@@ -78,9 +76,8 @@ var _ = Describe("Networking dual-stack-service,", Serial, Label("networking1"),
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 62508
 	It("service with no ipFamilyPolicy configured [negative]",
-		func() {
+		globalhelper.PolarionID("62508"), func() {
 			By("Define and create service")
 			err := tshelper.DefineAndCreateServiceOnCluster("testservice", randomNamespace, 3024,
 				3024, false, false, []corev1.IPFamily{"IPv4"}, "")
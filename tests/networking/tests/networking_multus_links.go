@@ -37,8 +37,7 @@ var _ = Describe("Networking custom namespace,", Serial, Label("networking2"), f
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 48328
-	It("custom deployment 3 pods, 1 NAD, connectivity via Multus secondary interface", func() {
+	It("custom deployment 3 pods, 1 NAD, connectivity via Multus secondary interface", globalhelper.PolarionID("48328"), func() {
 		By("Define and create Network-attachment-definition")
 		err := tshelper.DefineAndCreateNadOnCluster(
 			tsparams.TestNadNameA, randomNamespace, tsparams.TestIPamIPNetworkA)
@@ -62,8 +61,7 @@ var _ = Describe("Networking custom namespace,", Serial, Label("networking2"), f
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48330
-	It("2 custom deployments 3 pods, 1 NAD, connectivity via Multus secondary interface", func() {
+	It("2 custom deployments 3 pods, 1 NAD, connectivity via Multus secondary interface", globalhelper.PolarionID("48330"), func() {
 		// The NetworkAttachmentDefinition (mcvlan) created for this TC uses the default interface that is connecting
 		// all worker/master nodes so that pods have connectivity irrespective of the node they are scheduled on
 		// see https://github.com/redhat-best-practices-for-k8s/certsuite-qe/pull/263
@@ -95,8 +93,8 @@ var _ = Describe("Networking custom namespace,", Serial, Label("networking2"), f
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48331
-	It("custom deployment and daemonset 3 pods, 2 NADs, connectivity via Multus secondary interfaces", func() {
+	It("custom deployment and daemonset 3 pods, 2 NADs, connectivity via Multus secondary "+
+		"interfaces", globalhelper.PolarionID("48331"), func() {
 		By("Define and create Network-attachment-definition")
 		err := tshelper.DefineAndCreateNadOnCluster(
 			tsparams.TestNadNameA, randomNamespace, tsparams.TestIPamIPNetworkA)
@@ -129,8 +127,8 @@ var _ = Describe("Networking custom namespace,", Serial, Label("networking2"), f
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48334
-	It("custom deployment 3 pods, 1 NAD missing IP, connectivity via Multus secondary interface[skip]", func() {
+	It("custom deployment 3 pods, 1 NAD missing IP, connectivity via Multus secondary "+
+		"interface[skip]", globalhelper.PolarionID("48334"), func() {
 		By("Define and create Network-attachment-definition")
 		err := tshelper.DefineAndCreateNadOnCluster(tsparams.TestNadNameA, randomNamespace, "")
 		Expect(err).ToNot(HaveOccurred())
@@ -153,8 +151,8 @@ var _ = Describe("Networking custom namespace,", Serial, Label("networking2"), f
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48338
-	It("custom deployments 3 pods and 1 pod, standalone IP, connectivity via Multus secondary interface[skip]", func() {
+	It("custom deployments 3 pods and 1 pod, standalone IP, connectivity via Multus secondary "+
+		"interface[skip]", globalhelper.PolarionID("48338"), func() {
 		By("Define and create Network-attachment-definitions")
 		err := tshelper.DefineAndCreateNadOnCluster(
 			tsparams.TestNadNameA, randomNamespace, tsparams.TestIPamIPNetworkA)
@@ -186,9 +184,8 @@ var _ = Describe("Networking custom namespace,", Serial, Label("networking2"), f
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48343
 	It("custom deployment and daemonset 3 pods, daemonset missing ip, 2 NADs, connectivity via Multus "+
-		"secondary interface", func() {
+		"secondary interface", globalhelper.PolarionID("48343"), func() {
 		By("Define and create network-attachment-definitions")
 		err := tshelper.DefineAndCreateNadOnCluster(
 			tsparams.TestNadNameA, randomNamespace, tsparams.TestIPamIPNetworkA)
@@ -220,8 +217,7 @@ var _ = Describe("Networking custom namespace,", Serial, Label("networking2"), f
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48580
-	It("custom daemonset 3 pods with skip label [skip]", func() {
+	It("custom daemonset 3 pods with skip label [skip]", globalhelper.PolarionID("48580"), func() {
 		By("Define and create network-attachment-definitions")
 		err := tshelper.DefineAndCreateNadOnCluster(
 			tsparams.TestNadNameA, randomNamespace, tsparams.TestIPamIPNetworkA)
@@ -244,8 +240,7 @@ var _ = Describe("Networking custom namespace,", Serial, Label("networking2"), f
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48582
-	It("custom deployment and daemonset 3 pods with skip label[skip]", func() {
+	It("custom deployment and daemonset 3 pods with skip label[skip]", globalhelper.PolarionID("48582"), func() {
 		By("Define and create network-attachment-definitions")
 		err := tshelper.DefineAndCreateNadOnCluster(
 			tsparams.TestNadNameA, randomNamespace, tsparams.TestIPamIPNetworkA)
@@ -273,8 +268,7 @@ var _ = Describe("Networking custom namespace,", Serial, Label("networking2"), f
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48582
-	It("custom deployment and daemonSet 3 pods, daemonSet has skip label", func() {
+	It("custom deployment and daemonSet 3 pods, daemonSet has skip label", globalhelper.PolarionID("48582"), func() {
 		By("Define and create network-attachment-definitions")
 		err := tshelper.DefineAndCreateNadOnCluster(
 			tsparams.TestNadNameA, randomNamespace, tsparams.TestIPamIPNetworkA)
@@ -302,8 +296,7 @@ var _ = Describe("Networking custom namespace,", Serial, Label("networking2"), f
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48582
-	It("custom deployment 3 pods, 2 NADs, multiple Multus interfaces on deployment", func() {
+	It("custom deployment 3 pods, 2 NADs, multiple Multus interfaces on deployment", globalhelper.PolarionID("48582"), func() {
 		By("Define and create network-attachment-definitions")
 		err := tshelper.DefineAndCreateNadOnCluster(
 			tsparams.TestNadNameA, randomNamespace, tsparams.TestIPamIPNetworkA)
@@ -331,8 +324,8 @@ var _ = Describe("Networking custom namespace,", Serial, Label("networking2"), f
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48346
-	It("custom deployment 3 pods,1 NAD,no connectivity via Multus secondary interface[negative]", func() {
+	It("custom deployment 3 pods,1 NAD,no connectivity via Multus secondary "+
+		"interface[negative]", globalhelper.PolarionID("48346"), func() {
 		By("Define and create Network-attachment-definition")
 		err := tshelper.DefineAndCreateNadOnCluster(
 			tsparams.TestNadNameA, randomNamespace, tsparams.TestIPamIPNetworkA)
@@ -360,9 +353,8 @@ var _ = Describe("Networking custom namespace,", Serial, Label("networking2"), f
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48347
 	It("custom deployment and daemonset 3 pods, 2 NADs, No connectivity on daemonset via Multus secondary "+
-		"interface[negative]", func() {
+		"interface[negative]", globalhelper.PolarionID("48347"), func() {
 		err := tshelper.DefineAndCreateNadOnCluster(
 			tsparams.TestNadNameA, randomNamespace, tsparams.TestIPamIPNetworkA)
 		Expect(err).ToNot(HaveOccurred())
@@ -397,9 +389,8 @@ var _ = Describe("Networking custom namespace,", Serial, Label("networking2"), f
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 48590
 	It("custom deployment and daemonset 3 pods, 2 NADs, multiple Multus interfaces on deployment no "+
-		"connectivity via secondary interface[negative]", func() {
+		"connectivity via secondary interface[negative]", globalhelper.PolarionID("48590"), func() {
 		By("Define and create network-attachment-definitions")
 		err := tshelper.DefineAndCreateNadOnCluster(
 			tsparams.TestNadNameA, randomNamespace, tsparams.TestIPamIPNetworkA)
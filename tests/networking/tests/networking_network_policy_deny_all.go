@@ -37,8 +37,8 @@ var _ = Describe("Networking network-policy-deny-all,", Serial, Label("networkin
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 59740
-	It("one deployment, one pod in a namespace with deny all ingress and egress network policy", func() {
+	It("one deployment, one pod in a namespace with deny all ingress and egress network "+
+		"policy", globalhelper.PolarionID("59740"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentOnCluster(1, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -66,8 +66,8 @@ var _ = Describe("Networking network-policy-deny-all,", Serial, Label("networkin
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 59741
-	It("one deployment, one pod in a namespace with only deny all ingress network policy [negative]", func() {
+	It("one deployment, one pod in a namespace with only deny all ingress network policy "+
+		"[negative]", globalhelper.PolarionID("59741"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentOnCluster(1, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -95,8 +95,8 @@ var _ = Describe("Networking network-policy-deny-all,", Serial, Label("networkin
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 59742
-	It("one deployment, one pod in a namespace with only deny all egress network policy [negative]", func() {
+	It("one deployment, one pod in a namespace with only deny all egress network policy "+
+		"[negative]", globalhelper.PolarionID("59742"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentOnCluster(1, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -124,8 +124,8 @@ var _ = Describe("Networking network-policy-deny-all,", Serial, Label("networkin
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 59743
-	It("one deployment, one pod in a namespace with neither deny all ingress or egress network policy [negative]", func() {
+	It("one deployment, one pod in a namespace with neither deny all ingress or egress network policy "+
+		"[negative]", globalhelper.PolarionID("59743"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentOnCluster(1, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -148,9 +148,8 @@ var _ = Describe("Networking network-policy-deny-all,", Serial, Label("networkin
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 59744
 	It("two deployments in different namespaces, one pod each, namespaces have deny all ingress and egress network policy",
-		func() {
+		globalhelper.PolarionID("59744"), func() {
 			By("Define first deployment and create it on cluster")
 			err := tshelper.DefineAndCreateDeploymentOnCluster(1, randomNamespace)
 			Expect(err).ToNot(HaveOccurred())
@@ -211,9 +210,8 @@ var _ = Describe("Networking network-policy-deny-all,", Serial, Label("networkin
 			Expect(err).ToNot(HaveOccurred())
 		})
 
-	// 59745
 	It("two deployments in different namespaces, one pod each, one namespace has only deny all egress network policy [negative]",
-		func() {
+		globalhelper.PolarionID("59745"), func() {
 			By("Define first deployment and create it on cluster")
 			err := tshelper.DefineAndCreateDeploymentOnCluster(1, randomNamespace)
 			Expect(err).ToNot(HaveOccurred())
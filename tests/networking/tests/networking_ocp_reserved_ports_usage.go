@@ -38,8 +38,7 @@ var _ = Describe("Networking ocp-reserved-ports-usage,", Serial, Label("networki
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 59536
-	It("one deployment, one pod, one container not declaring reserved ports (OCP Ports)", func() {
+	It("one deployment, one pod, one container not declaring reserved ports (OCP Ports)", globalhelper.PolarionID("59536"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentOnCluster(1, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -57,8 +56,7 @@ var _ = Describe("Networking ocp-reserved-ports-usage,", Serial, Label("networki
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 59537
-	It("one deployment, one pod, one container declaring reserved ports [negative]", func() {
+	It("one deployment, one pod, one container declaring reserved ports [negative]", globalhelper.PolarionID("59537"), func() {
 		By("Define and create deployment with container declaring reserved port")
 		err := tshelper.DefineAndCreateDeploymentWithContainerPorts(1, []corev1.ContainerPort{{ContainerPort: 22623}}, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -76,8 +74,8 @@ var _ = Describe("Networking ocp-reserved-ports-usage,", Serial, Label("networki
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 59538
-	It("one deployment, one pod, two containers, neither declaring reserved ports 22222 and 22223 (OCP Ports)", func() {
+	It("one deployment, one pod, two containers, neither declaring reserved ports 22222 and 22223 (OCP "+
+		"Ports)", globalhelper.PolarionID("59538"), func() {
 		By("Define deployment with two containers")
 		ports := []corev1.ContainerPort{{ContainerPort: 22222}, {ContainerPort: 22223}}
 		err := tshelper.DefineAndCreateDeploymentWithContainerPorts(2, ports, randomNamespace)
@@ -96,8 +94,8 @@ var _ = Describe("Networking ocp-reserved-ports-usage,", Serial, Label("networki
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 59539
-	It("one deployment, one pod, two containers, one declaring reserved ports (OCP Ports) [negative]", func() {
+	It("one deployment, one pod, two containers, one declaring reserved ports (OCP Ports) "+
+		"[negative]", globalhelper.PolarionID("59539"), func() {
 		ports := []corev1.ContainerPort{{ContainerPort: 22222}, {ContainerPort: 22623}}
 
 		By("Define deployment with two containers")
@@ -117,8 +115,7 @@ var _ = Describe("Networking ocp-reserved-ports-usage,", Serial, Label("networki
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 59540
-	It("one deployment, one pod not listening on reserved ports (OCP Ports)", func() {
+	It("one deployment, one pod not listening on reserved ports (OCP Ports)", globalhelper.PolarionID("59540"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentOnCluster(3, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -136,8 +133,7 @@ var _ = Describe("Networking ocp-reserved-ports-usage,", Serial, Label("networki
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 59541
-	It("one deployment, one pod listening on reserved ports (OCP Ports) [negative]", func() {
+	It("one deployment, one pod listening on reserved ports (OCP Ports) [negative]", globalhelper.PolarionID("59541"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentWithContainerPorts(1, []corev1.ContainerPort{{ContainerPort: 22624}}, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -160,8 +156,7 @@ var _ = Describe("Networking ocp-reserved-ports-usage,", Serial, Label("networki
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 59542
-	It("two deployments, one pod each not listening on reserved ports (OCP Ports)", func() {
+	It("two deployments, one pod each not listening on reserved ports (OCP Ports)", globalhelper.PolarionID("59542"), func() {
 		By("Define first deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentOnCluster(3, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -183,8 +178,7 @@ var _ = Describe("Networking ocp-reserved-ports-usage,", Serial, Label("networki
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 59543
-	It("two deployments, one pod each, one listening on reserved ports (OCP Ports) [negative]", func() {
+	It("two deployments, one pod each, one listening on reserved ports (OCP Ports) [negative]", globalhelper.PolarionID("59543"), func() {
 		By("Define first deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeployment(tsparams.TestDeploymentBName, randomNamespace, 3)
 		Expect(err).ToNot(HaveOccurred())
@@ -38,8 +38,7 @@ var _ = Describe("Networking reserved-partner-ports,", Serial, Label("networking
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 61487
-	It("one deployment, one pod, one container not declaring reserved ports (Partner Ports)", func() {
+	It("one deployment, one pod, one container not declaring reserved ports (Partner Ports)", globalhelper.PolarionID("61487"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentOnCluster(1, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -57,8 +56,8 @@ var _ = Describe("Networking reserved-partner-ports,", Serial, Label("networking
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 61505
-	It("one deployment, one pod, one container declaring reserved ports (Partner Ports) [negative]", func() {
+	It("one deployment, one pod, one container declaring reserved ports (Partner Ports) "+
+		"[negative]", globalhelper.PolarionID("61505"), func() {
 		By("Define and create deployment with container declaring reserved port")
 		err := tshelper.DefineAndCreateDeploymentWithContainerPorts(1, []corev1.ContainerPort{{ContainerPort: 15443}}, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -81,8 +80,8 @@ var _ = Describe("Networking reserved-partner-ports,", Serial, Label("networking
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 61506
-	It("one deployment, one pod, two containers, neither declaring reserved ports 15002 and 15007 (Partner Ports)", func() {
+	It("one deployment, one pod, two containers, neither declaring reserved ports 15002 and 15007 (Partner "+
+		"Ports)", globalhelper.PolarionID("61506"), func() {
 		By("Define deployment with two containers")
 		ports := []corev1.ContainerPort{{ContainerPort: 15002}, {ContainerPort: 15007}}
 		err := tshelper.DefineAndCreateDeploymentWithContainerPorts(2, ports, randomNamespace)
@@ -101,8 +100,8 @@ var _ = Describe("Networking reserved-partner-ports,", Serial, Label("networking
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 61507
-	It("one deployment, one pod, two containers, one declaring reserved ports (Partner Ports) [negative]", func() {
+	It("one deployment, one pod, two containers, one declaring reserved ports (Partner Ports) "+
+		"[negative]", globalhelper.PolarionID("61507"), func() {
 		ports := []corev1.ContainerPort{{ContainerPort: 15020}, {ContainerPort: 15019}}
 
 		By("Define deployment with two containers")
@@ -122,8 +121,7 @@ var _ = Describe("Networking reserved-partner-ports,", Serial, Label("networking
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 61508
-	It("one deployment, one pod not listening on reserved ports (Partner Ports)", func() {
+	It("one deployment, one pod not listening on reserved ports (Partner Ports)", globalhelper.PolarionID("61508"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentOnCluster(3, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -141,8 +139,7 @@ var _ = Describe("Networking reserved-partner-ports,", Serial, Label("networking
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 61509
-	It("one deployment, one pod listening on reserved ports (Partner Ports) [negative]", func() {
+	It("one deployment, one pod listening on reserved ports (Partner Ports) [negative]", globalhelper.PolarionID("61509"), func() {
 		By("Define deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentWithContainerPorts(1, []corev1.ContainerPort{{ContainerPort: 15021}}, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -165,8 +162,7 @@ var _ = Describe("Networking reserved-partner-ports,", Serial, Label("networking
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 61510
-	It("two deployments, one pod each not listening on reserved ports (Partner Ports)", func() {
+	It("two deployments, one pod each not listening on reserved ports (Partner Ports)", globalhelper.PolarionID("61510"), func() {
 		By("Define first deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeploymentOnCluster(3, randomNamespace)
 		Expect(err).ToNot(HaveOccurred())
@@ -188,8 +184,8 @@ var _ = Describe("Networking reserved-partner-ports,", Serial, Label("networking
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 61517
-	It("two deployments, one pod each, one listening on reserved ports (Partner Ports) [negative]", func() {
+	It("two deployments, one pod each, one listening on reserved ports (Partner Ports) "+
+		"[negative]", globalhelper.PolarionID("61517"), func() {
 		By("Define first deployment and create it on cluster")
 		err := tshelper.DefineAndCreateDeployment(tsparams.TestDeploymentBName, randomNamespace, 3)
 		Expect(err).ToNot(HaveOccurred())
@@ -16,10 +16,11 @@ import (
 )
 
 func TestObservability(t *testing.T) {
+	globalhelper.RegisterLaunchPolicies(tsparams.LaunchPolicies)
 	globalhelper.RunSuite(t, "CNFCert observability tests")
 }
 
-var _ = SynchronizedBeforeSuite(func() {
+var _ = SynchronizedBeforeSuite(func() []byte {
 	By(fmt.Sprintf("Create %s namespace", tsparams.TestNamespace))
 	err := globalhelper.CreateNamespace(tsparams.TestNamespace)
 	Expect(err).ToNot(HaveOccurred())
@@ -32,7 +33,18 @@ var _ = SynchronizedBeforeSuite(func() {
 		[]string{},
 		[]string{tsparams.CrdSuffix1, tsparams.CrdSuffix2}, globalhelper.GetConfiguration().General.CertsuiteConfigDir)
 	Expect(err).ToNot(HaveOccurred())
-}, func() {})
+
+	By("Check certsuite image")
+	Expect(globalhelper.ValidateCertsuiteImage()).To(Succeed(), "Error checking certsuite image")
+
+	By("Build certsuite binary")
+	certsuiteBinary, err := globalhelper.BuildCertsuiteBinary()
+	Expect(err).ToNot(HaveOccurred(), "Error building certsuite binary")
+
+	return []byte(certsuiteBinary)
+}, func(certsuiteBinary []byte) {
+	globalhelper.SetCertsuiteBinary(string(certsuiteBinary))
+})
 
 var _ = SynchronizedAfterSuite(func() {}, func() {
 	By(fmt.Sprintf("Remove %s namespace", tsparams.TestNamespace))
@@ -85,3 +85,8 @@ var (
 
 	NsResourcesDeleteTimeoutMins = 5 * time.Minute
 )
+
+// LaunchPolicies sets the certsuite run timeout and attempts of the observability test cases.
+var LaunchPolicies = map[string]globalparameters.LaunchPolicy{
+	globalparameters.ObservabilitySuiteName: {Timeout: 10 * time.Minute},
+}
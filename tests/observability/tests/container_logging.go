@@ -38,8 +38,7 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 			randomReportDir, randomCertsuiteConfigDir, tsparams.CrdDeployTimeoutMins)
 	})
 
-	// 51747
-	It("One deployment one pod one container that prints two log lines", func() {
+	It("One deployment one pod one container that prints two log lines", globalhelper.PolarionID("51747"), func() {
 		By("Define deployment")
 		deployment := tshelper.DefineDeploymentWithStdoutBuffers(
 			tsparams.TestDeploymentBaseName, randomNamespace, 1,
@@ -66,8 +65,7 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51753
-	It("One deployment one pod one container that prints one log line", func() {
+	It("One deployment one pod one container that prints one log line", globalhelper.PolarionID("51753"), func() {
 		By("Define deployment")
 		deployment := tshelper.DefineDeploymentWithStdoutBuffers(
 			tsparams.TestDeploymentBaseName, randomNamespace, 1,
@@ -93,8 +91,8 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51754
-	It("One deployment one pod with two containers, both containers print two log lines to stdout", func() {
+	It("One deployment one pod with two containers, both containers print two log lines to "+
+		"stdout", globalhelper.PolarionID("51754"), func() {
 		By("Define deployment")
 		deployment := tshelper.DefineDeploymentWithStdoutBuffers(
 			tsparams.TestDeploymentBaseName, randomNamespace, 1,
@@ -120,8 +118,7 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51755
-	It("One daemonset with two containers, first prints two lines, the second one line", func() {
+	It("One daemonset with two containers, first prints two lines, the second one line", globalhelper.PolarionID("51755"), func() {
 		if globalhelper.IsKindCluster() {
 			Skip("Test skipped on KIND cluster due to newline char issue")
 		}
@@ -152,8 +149,7 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51756
-	It("Two deployments, two pods with two containers each, all printing 1 log line", func() {
+	It("Two deployments, two pods with two containers each, all printing 1 log line", globalhelper.PolarionID("51756"), func() {
 		if globalhelper.IsKindCluster() {
 			Skip("Test skipped on KIND cluster due to newline char issue")
 		}
@@ -197,9 +193,8 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51757
 	It("One deployment and one statefulset, both having one pod with one container that prints one log "+
-		"line each", func() {
+		"line each", globalhelper.PolarionID("51757"), func() {
 		By("Define deployment")
 		deployment := tshelper.DefineDeploymentWithStdoutBuffers(
 			tsparams.TestDeploymentBaseName, randomNamespace, 1,
@@ -239,8 +234,7 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51758
-	It("One pod with one container that prints one log line to stdout", func() {
+	It("One pod with one container that prints one log line to stdout", globalhelper.PolarionID("51758"), func() {
 		By("Create pod in the cluster")
 		pod := tshelper.DefinePodWithStdoutBuffer(
 			tsparams.TestPodBaseName, randomNamespace, tsparams.OneLogLine)
@@ -264,8 +258,8 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51759
-	It("One pod with one container that prints to stdout one log line starting with a tab char", func() {
+	It("One pod with one container that prints to stdout one log line starting with a tab "+
+		"char", globalhelper.PolarionID("51759"), func() {
 		By("Create pod in the cluster")
 		pod := tshelper.DefinePodWithStdoutBuffer(tsparams.TestPodBaseName, randomNamespace,
 			"\t"+tsparams.OneLogLine)
@@ -289,8 +283,7 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51760
-	It("One deployment one pod one container without any log line to stdout [negative]", func() {
+	It("One deployment one pod one container without any log line to stdout [negative]", globalhelper.PolarionID("51760"), func() {
 		By("Define deployment")
 		deployment := tshelper.DefineDeploymentWithStdoutBuffers(
 			tsparams.TestDeploymentBaseName, randomNamespace, 1,
@@ -316,8 +309,7 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51761
-	It("One deployment one pod two containers but only one printing one log line [negative]", func() {
+	It("One deployment one pod two containers but only one printing one log line [negative]", globalhelper.PolarionID("51761"), func() {
 		By("Define deployment")
 		deployment := tshelper.DefineDeploymentWithStdoutBuffers(
 			tsparams.TestDeploymentBaseName, randomNamespace, 1,
@@ -343,8 +335,8 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51762
-	It("Two deployments one pod two containers each, first deployment passing but second fails [negative]", func() {
+	It("Two deployments one pod two containers each, first deployment passing but second fails "+
+		"[negative]", globalhelper.PolarionID("51762"), func() {
 		By("Create deployment1 in the cluster whose containers print one line to stdout each")
 		deployment1 := tshelper.DefineDeploymentWithStdoutBuffers(
 			tsparams.TestDeploymentBaseName+"1", randomNamespace, 1,
@@ -384,8 +376,7 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51763
-	It("One pod one container without any log line to stdout [negative]", func() {
+	It("One pod one container without any log line to stdout [negative]", globalhelper.PolarionID("51763"), func() {
 		By("Create pod in the cluster")
 		pod := tshelper.DefinePodWithStdoutBuffer(tsparams.TestPodBaseName, randomNamespace,
 			tsparams.NoLogLines)
@@ -409,9 +400,8 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51764
 	It("One deployment and one statefulset both one container each, but only deployment prints "+
-		"one log line [negative]", func() {
+		"one log line [negative]", globalhelper.PolarionID("51764"), func() {
 		By("Define deployment")
 		deployment := tshelper.DefineDeploymentWithStdoutBuffers(
 			tsparams.TestDeploymentBaseName, randomNamespace, 1,
@@ -451,8 +441,7 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51765
-	It("One deployment one pod one container printing one log line without newline char", func() {
+	It("One deployment one pod one container printing one log line without newline char", globalhelper.PolarionID("51765"), func() {
 		if globalhelper.IsKindCluster() {
 			Skip("Test skipped on KIND cluster due to newline char issue")
 		}
@@ -482,9 +471,8 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51767
 	It("One deployment one pod two containers, first prints one line, second prints "+
-		"one line without newline", func() {
+		"one line without newline", globalhelper.PolarionID("51767"), func() {
 		if globalhelper.IsKindCluster() {
 			Skip("Test skipped on KIND cluster due to newline char issue")
 		}
@@ -514,8 +502,7 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51768
-	It("One deployment with one pod and one container without Certsuite target labels [skip]", func() {
+	It("One deployment with one pod and one container without Certsuite target labels [skip]", globalhelper.PolarionID("51768"), func() {
 		By("Create deployment without Certsuite target labels in the cluster")
 		deployment := tshelper.DefineDeploymentWithoutTargetLabels(
 			tsparams.TestDeploymentBaseName, randomNamespace)
@@ -535,8 +522,8 @@ var _ = Describe(tsparams.CertsuiteContainerLoggingTcName, Label("observability"
 		Expect(err).ToNot(HaveOccurred())
 
 		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(tsparams.CertsuiteContainerLoggingTcName,
-			globalparameters.TestCaseSkipped, randomReportDir)
+		err = globalhelper.ValidateIfReportsAreValidWithReason(tsparams.CertsuiteContainerLoggingTcName,
+			globalparameters.TestCaseSkipped, globalhelper.SkipReasonNoContainers, randomReportDir)
 		Expect(err).ToNot(HaveOccurred())
 	})
 })
@@ -58,8 +58,7 @@ var _ = Describe(tsparams.CertsuiteCrdStatusTcName, Serial, func() {
 			randomReportDir, randomCertsuiteConfigDir, tsparams.CrdDeployTimeoutMins)
 	})
 
-	// 52444
-	It("One CRD created with status subresource", func() {
+	It("One CRD created with status subresource", globalhelper.PolarionID("52444"), func() {
 		By(CreateCRDInClusterStr + tsparams.CrdSuffix1)
 		crd1 := tshelper.DefineCrdWithStatusSubresource("TestCrd", tsparams.CrdSuffix1)
 
@@ -82,8 +81,7 @@ var _ = Describe(tsparams.CertsuiteCrdStatusTcName, Serial, func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 52445
-	It("Two CRDs created, both with status subresource", func() {
+	It("Two CRDs created, both with status subresource", globalhelper.PolarionID("52445"), func() {
 		By(CreateCRDInClusterStr + tsparams.CrdSuffix1)
 		crd1 := tshelper.DefineCrdWithStatusSubresource("TestCrdOne", tsparams.CrdSuffix1)
 
@@ -113,8 +111,7 @@ var _ = Describe(tsparams.CertsuiteCrdStatusTcName, Serial, func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 52446
-	It("One CRD created without status subresource [negative]", func() {
+	It("One CRD created without status subresource [negative]", globalhelper.PolarionID("52446"), func() {
 		By(CreateCRDInClusterStr + tsparams.CrdSuffix1)
 		crd1 := tshelper.DefineCrdWithoutStatusSubresource("TestCrd", tsparams.CrdSuffix1)
 
@@ -137,8 +134,7 @@ var _ = Describe(tsparams.CertsuiteCrdStatusTcName, Serial, func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 52447
-	It("Two CRDs created, one with and the other without status subresource [negative]", func() {
+	It("Two CRDs created, one with and the other without status subresource [negative]", globalhelper.PolarionID("52447"), func() {
 		By(CreateCRDInClusterStr + tsparams.CrdSuffix1)
 		crd1 := tshelper.DefineCrdWithStatusSubresource("TestCrdOne", tsparams.CrdSuffix1)
 
@@ -168,8 +164,7 @@ var _ = Describe(tsparams.CertsuiteCrdStatusTcName, Serial, func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 52448
-	It("Two CRDs created, both without status subresource [negative]", func() {
+	It("Two CRDs created, both without status subresource [negative]", globalhelper.PolarionID("52448"), func() {
 		By(CreateCRDInClusterStr + tsparams.CrdSuffix1)
 		crd1 := tshelper.DefineCrdWithoutStatusSubresource("TestCrdOne", tsparams.CrdSuffix1)
 
@@ -199,8 +194,7 @@ var _ = Describe(tsparams.CertsuiteCrdStatusTcName, Serial, func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 52449
-	It("One CRD deployed not having any of the configured suffixes [skip]", func() {
+	It("One CRD deployed not having any of the configured suffixes [skip]", globalhelper.PolarionID("52449"), func() {
 		By(CreateCRDInClusterStr + tsparams.NotConfiguredCrdSuffix)
 		crd1 := tshelper.DefineCrdWithoutStatusSubresource("TestCrdOne",
 			tsparams.NotConfiguredCrdSuffix)
@@ -219,8 +213,8 @@ var _ = Describe(tsparams.CertsuiteCrdStatusTcName, Serial, func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(tsparams.CertsuiteCrdStatusTcName, globalparameters.TestCaseSkipped,
-			randomReportDir)
+		err = globalhelper.ValidateIfReportsAreValidWithReason(tsparams.CertsuiteCrdStatusTcName, globalparameters.TestCaseSkipped,
+			globalhelper.SkipReasonNoCrds, randomReportDir)
 		Expect(err).ToNot(HaveOccurred())
 	})
 })
@@ -45,8 +45,7 @@ var _ = Describe(tsparams.CertsuitePodDisruptionBudgetTcName, func() {
 			randomReportDir, randomCertsuiteConfigDir, tsparams.CrdDeployTimeoutMins)
 	})
 
-	// 56635
-	It("One deployment, pod disruption budget minAvailable value meet requirements", func() {
+	It("One deployment, pod disruption budget minAvailable value meet requirements", globalhelper.PolarionID("56635"), func() {
 		By("Define deployment")
 		dep := deployment.DefineDeployment(tsparams.TestDeploymentBaseName, randomNamespace,
 			tsparams.SampleWorkloadImage, tsparams.CertsuiteTargetPodLabels)
@@ -80,8 +79,7 @@ var _ = Describe(tsparams.CertsuitePodDisruptionBudgetTcName, func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56636
-	It("One deployment, pod disruption budget maxUnavailable value meet requirements", func() {
+	It("One deployment, pod disruption budget maxUnavailable value meet requirements", globalhelper.PolarionID("56636"), func() {
 		By("Define deployment")
 		dep := deployment.DefineDeployment(tsparams.TestDeploymentBaseName, randomNamespace,
 			tsparams.SampleWorkloadImage, tsparams.CertsuiteTargetPodLabels)
@@ -115,8 +113,7 @@ var _ = Describe(tsparams.CertsuitePodDisruptionBudgetTcName, func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56637
-	It("One statefulSet, pod disruption budget minAvailable value is zero [negative]", func() {
+	It("One statefulSet, pod disruption budget minAvailable value is zero [negative]", globalhelper.PolarionID("56637"), func() {
 		By("Create statefulSet")
 		myStatefulSet := statefulset.DefineStatefulSet(tsparams.TestStatefulSetBaseName, randomNamespace,
 			tsparams.SampleWorkloadImage, tsparams.CertsuiteTargetPodLabels)
@@ -150,8 +147,8 @@ var _ = Describe(tsparams.CertsuitePodDisruptionBudgetTcName, func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56638
-	It("One deployment, pod disruption budget maxUnavailable equals to replica number [negative]", func() {
+	It("One deployment, pod disruption budget maxUnavailable equals to replica number "+
+		"[negative]", globalhelper.PolarionID("56638"), func() {
 		By("Define deployment")
 		dep := deployment.DefineDeployment(tsparams.TestDeploymentBaseName, randomNamespace,
 			tsparams.SampleWorkloadImage, tsparams.CertsuiteTargetPodLabels)
@@ -185,8 +182,8 @@ var _ = Describe(tsparams.CertsuitePodDisruptionBudgetTcName, func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56746
-	It("One deployment, pod disruption budget maxUnavailable is bigger than the replica number [negative]", func() {
+	It("One deployment, pod disruption budget maxUnavailable is bigger than the replica number "+
+		"[negative]", globalhelper.PolarionID("56746"), func() {
 		By("Define deployment")
 		dep := deployment.DefineDeployment(tsparams.TestDeploymentBaseName, randomNamespace,
 			tsparams.SampleWorkloadImage, tsparams.CertsuiteTargetPodLabels)
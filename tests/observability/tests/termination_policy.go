@@ -339,8 +339,8 @@ var _ = Describe(tsparams.CertsuiteTerminationMsgPolicyTcName, func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		By("Verify test case status in Claim report")
-		err = globalhelper.ValidateIfReportsAreValid(tsparams.CertsuiteTerminationMsgPolicyTcName, globalparameters.TestCaseSkipped,
-			randomReportDir)
+		err = globalhelper.ValidateIfReportsAreValidWithReason(tsparams.CertsuiteTerminationMsgPolicyTcName,
+			globalparameters.TestCaseSkipped, globalhelper.SkipReasonNoContainers, randomReportDir)
 		Expect(err).ToNot(HaveOccurred())
 	})
 })
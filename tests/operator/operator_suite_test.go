@@ -9,14 +9,16 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
+	tsparams "github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/operator/parameters"
 	_ "github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/operator/tests"
 )
 
 func TestOperator(t *testing.T) {
+	globalhelper.RegisterLaunchPolicies(tsparams.LaunchPolicies)
 	globalhelper.RunSuite(t, "CNFCert operator tests")
 }
 
-var _ = SynchronizedBeforeSuite(func() {
+var _ = SynchronizedBeforeSuite(func() []byte {
 	if globalhelper.IsKindCluster() {
 		Skip("Skipping operator tests on kind cluster")
 	}
@@ -27,4 +29,15 @@ var _ = SynchronizedBeforeSuite(func() {
 		err := globalhelper.CreateAndValidateCatalogSources(true)
 		Expect(err).ToNot(HaveOccurred(), "All necessary catalog sources are not available")
 	}
-}, func() {})
+
+	By("Check certsuite image")
+	Expect(globalhelper.ValidateCertsuiteImage()).To(Succeed(), "Error checking certsuite image")
+
+	By("Build certsuite binary")
+	certsuiteBinary, err := globalhelper.BuildCertsuiteBinary()
+	Expect(err).ToNot(HaveOccurred(), "Error building certsuite binary")
+
+	return []byte(certsuiteBinary)
+}, func(certsuiteBinary []byte) {
+	globalhelper.SetCertsuiteBinary(string(certsuiteBinary))
+})
@@ -87,3 +87,8 @@ const (
 	OperatorPackageNamePrefixLightweight              = "prometheus-exporter-operator"
 	OperatorPackageNamePrefixLightweightCustomCatalog = "nginx-ingress-operator"
 )
+
+// LaunchPolicies sets the certsuite run timeout and attempts of the operator test cases.
+var LaunchPolicies = map[string]globalparameters.LaunchPolicy{
+	globalparameters.OperatorSuiteName: {Timeout: 15 * time.Minute},
+}
@@ -122,8 +122,7 @@ var _ = Describe("Operator install-source,", Serial, Label("operator", "ocp-requ
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 66142
-	It("one operator installed with OLM", func() {
+	It("one operator installed with OLM", globalhelper.PolarionID("66142"), func() {
 		By("Query the packagemanifest for Grafana operator package name and catalog source")
 		grafanaOperatorName, catalogSource := globalhelper.CheckOperatorExistsOrSkip("grafana", randomNamespace)
 
@@ -174,8 +173,7 @@ var _ = Describe("Operator install-source,", Serial, Label("operator", "ocp-requ
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 66143
-	It("one operator not installed with OLM [negative]", func() {
+	It("one operator not installed with OLM [negative]", globalhelper.PolarionID("66143"), func() {
 		// Note: This test uses a lightweight operator that varies by OCP version
 		// See issue #1283 and operatorversions package for operator catalog availability
 		ocpVersion := "4.19"
@@ -256,8 +254,7 @@ var _ = Describe("Operator install-source,", Serial, Label("operator", "ocp-requ
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 66144
-	It("two operators, both installed with OLM", func() {
+	It("two operators, both installed with OLM", globalhelper.PolarionID("66144"), func() {
 		// Note: This test uses grafana-operator and a lightweight operator that varies by OCP version
 		// See issue #1283 and operatorversions package for operator catalog availability
 		ocpVersion := "4.19"
@@ -354,8 +351,7 @@ var _ = Describe("Operator install-source,", Serial, Label("operator", "ocp-requ
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 66145
-	It("two operators, one not installed with OLM [negative]", func() {
+	It("two operators, one not installed with OLM [negative]", globalhelper.PolarionID("66145"), func() {
 		// Note: This test uses a lightweight operator that varies by OCP version
 		// See issue #1283 and operatorversions package for operator catalog availability
 		ocpVersion := "4.19"
@@ -118,8 +118,7 @@ var _ = Describe("Operator install-status-no-privileges,", Serial, Label("operat
 			randomReportDir, randomCertsuiteConfigDir, tsparams.Timeout)
 	})
 
-	// 66381
-	It("one operator with no clusterPermissions", func() {
+	It("one operator with no clusterPermissions", globalhelper.PolarionID("66381"), func() {
 		By("Label operator")
 		Eventually(func() error {
 			return tshelper.AddLabelToInstalledCSV(
@@ -144,8 +143,7 @@ var _ = Describe("Operator install-status-no-privileges,", Serial, Label("operat
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 66383
-	It("one operator with clusterPermissions [negative]", func() {
+	It("one operator with clusterPermissions [negative]", globalhelper.PolarionID("66383"), func() {
 		Eventually(func() error {
 			return tshelper.AddLabelToInstalledCSV(
 				operatorName,
@@ -169,8 +167,7 @@ var _ = Describe("Operator install-status-no-privileges,", Serial, Label("operat
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 66384
-	It("two operators, one with no clusterPermissions and one with clusterPermissions", func() {
+	It("two operators, one with no clusterPermissions and one with clusterPermissions", globalhelper.PolarionID("66384"), func() {
 		By("Label operators")
 		Eventually(func() error {
 			return tshelper.AddLabelToInstalledCSV(
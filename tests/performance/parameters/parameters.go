@@ -2,6 +2,7 @@ package parameters
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
 )
@@ -46,3 +47,8 @@ const (
 
 	SampleWorkloadImage = "quay.io/redhat-best-practices-for-k8s/certsuite-sample-workload:latest"
 )
+
+// LaunchPolicies sets the certsuite run timeout and attempts of the performance test cases.
+var LaunchPolicies = map[string]globalparameters.LaunchPolicy{
+	globalparameters.PerformanceSuiteName: {Timeout: 15 * time.Minute},
+}
@@ -5,11 +5,29 @@ package performance
 import (
 	"testing"
 
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
 	_ "github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/performance/tests"
 
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalhelper"
+	tsparams "github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/performance/parameters"
 )
 
+var _ = SynchronizedBeforeSuite(func() []byte {
+	By("Check certsuite image")
+	Expect(globalhelper.ValidateCertsuiteImage()).To(Succeed(), "Error checking certsuite image")
+
+	By("Build certsuite binary")
+	certsuiteBinary, err := globalhelper.BuildCertsuiteBinary()
+	Expect(err).ToNot(HaveOccurred(), "Error building certsuite binary")
+
+	return []byte(certsuiteBinary)
+}, func(certsuiteBinary []byte) {
+	globalhelper.SetCertsuiteBinary(string(certsuiteBinary))
+})
+
 func TestPerformance(t *testing.T) {
+	globalhelper.RegisterLaunchPolicies(tsparams.LaunchPolicies)
 	globalhelper.RunSuite(t, "CNFCert performance tests")
 }
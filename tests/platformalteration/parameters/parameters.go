@@ -61,3 +61,8 @@ const (
 
 	IstioVersion = "1.30.3"
 )
+
+// LaunchPolicies sets the certsuite run timeout and attempts of the platform-alteration test cases.
+var LaunchPolicies = map[string]globalparameters.LaunchPolicy{
+	globalparameters.PlatformAlterationSuiteName: {Timeout: 20 * time.Minute},
+}
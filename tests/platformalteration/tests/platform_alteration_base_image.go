@@ -16,7 +16,8 @@ import (
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/statefulset"
 )
 
-var _ = Describe("platform-alteration-base-image", Label("platformalteration1", "ocp-required"), func() {
+//nolint:lll
+var _ = Describe("platform-alteration-base-image", Label("platformalteration1", "ocp-required"), globalhelper.RequiresCapability(globalhelper.CapabilityMCO, globalhelper.CapabilityWorkerNodes), func() {
 	var (
 		randomNamespace          string
 		randomReportDir          string
@@ -37,24 +38,6 @@ var _ = Describe("platform-alteration-base-image", Label("platformalteration1",
 			[]string{},
 			[]string{}, randomCertsuiteConfigDir)
 		Expect(err).ToNot(HaveOccurred())
-
-		if globalhelper.IsKindCluster() {
-			// The Certsuite actually proactively skips this test if the cluster is Non-OCP.
-			Skip(fmt.Sprintf("%s test is not applicable for Kind cluster", tsparams.CertsuiteBaseImageName))
-		}
-
-		By("Verify MCO is healthy and accessible")
-
-		mcoHealthy, err := globalhelper.IsMCOHealthy()
-		if err != nil || !mcoHealthy {
-			Skip("MCO is not healthy or accessible on this cluster - skipping base image tests")
-		}
-
-		By("Verify cluster has worker nodes")
-
-		if !globalhelper.HasWorkerNodes() {
-			Skip("Cluster has no worker nodes - skipping base image tests")
-		}
 	})
 
 	AfterEach(func() {
@@ -62,8 +45,7 @@ var _ = Describe("platform-alteration-base-image", Label("platformalteration1",
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 51297
-	It("One deployment, one pod, running test image", func() {
+	It("One deployment, one pod, running test image", globalhelper.PolarionID("51297"), func() {
 		By("Define deployment")
 		dep := deployment.DefineDeployment(tsparams.TestDeploymentName,
 			randomNamespace,
@@ -129,8 +111,7 @@ var _ = Describe("platform-alteration-base-image", Label("platformalteration1",
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51298
-	It("One daemonSet, running test image", func() {
+	It("One daemonSet, running test image", globalhelper.PolarionID("51298"), func() {
 		By("Define daemonSet")
 		testDaemonSet := daemonset.DefineDaemonSet(randomNamespace,
 			tsparams.SampleWorkloadImage,
@@ -201,8 +182,8 @@ var _ = Describe("platform-alteration-base-image", Label("platformalteration1",
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51299
-	It("Two deployments, one pod each, change container base image by creating a file [negative]", func() {
+	It("Two deployments, one pod each, change container base image by creating a file "+
+		"[negative]", globalhelper.PolarionID("51299"), func() {
 		By("Define first deployment")
 		deploymenta := deployment.DefineDeployment(tsparams.TestDeploymentName, randomNamespace,
 			tsparams.SampleWorkloadImage, tsparams.CertsuiteTargetPodLabels)
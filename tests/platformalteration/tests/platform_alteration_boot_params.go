@@ -17,7 +17,8 @@ import (
 	. "github.com/onsi/gomega"
 )
 
-var _ = Describe("platform-alteration-boot-params", Label("platformalteration1", "ocp-required"), func() {
+//nolint:lll
+var _ = Describe("platform-alteration-boot-params", Label("platformalteration1", "ocp-required"), globalhelper.RequiresCapability(globalhelper.CapabilityMCO), func() {
 	var (
 		randomNamespace          string
 		randomReportDir          string
@@ -39,19 +40,6 @@ var _ = Describe("platform-alteration-boot-params", Label("platformalteration1",
 			[]string{}, randomCertsuiteConfigDir)
 		Expect(err).ToNot(HaveOccurred())
 
-		By("If Kind cluster, skip")
-
-		if globalhelper.IsKindCluster() {
-			Skip("Kind cluster does not support MCO")
-		}
-
-		By("Verify MCO is healthy and accessible")
-
-		mcoHealthy, err := globalhelper.IsMCOHealthy()
-		if err != nil || !mcoHealthy {
-			Skip("MCO is not healthy or accessible on this cluster - skipping boot params tests")
-		}
-
 		By("Verify MachineConfigPools exist")
 
 		mcpList, err := globalhelper.GetAPIClient().MachineConfigPools().List(context.TODO(), metav1.ListOptions{})
@@ -65,8 +53,7 @@ var _ = Describe("platform-alteration-boot-params", Label("platformalteration1",
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 51302
-	It("unchanged boot params", func() {
+	It("unchanged boot params", globalhelper.PolarionID("51302"), func() {
 		By("Create daemonSet")
 		testDaemonSet := daemonset.DefineDaemonSet(randomNamespace, tsparams.SampleWorkloadImage,
 			tsparams.CertsuiteTargetPodLabels, tsparams.TestDaemonSetName)
@@ -163,8 +150,7 @@ var _ = Describe("platform-alteration-boot-params", Label("platformalteration1",
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51305
-	It("change boot params using MCO", func() {
+	It("change boot params using MCO", globalhelper.PolarionID("51305"), func() {
 		machineConfigList, err := globalhelper.GetAPIClient().MachineConfigs().List(context.TODO(), metav1.ListOptions{})
 		Expect(err).ToNot(HaveOccurred())
 
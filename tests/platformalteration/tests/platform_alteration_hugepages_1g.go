@@ -14,7 +14,8 @@ import (
 	. "github.com/onsi/gomega"
 )
 
-var _ = Describe("platform-alteration-hugepages-1g-only", Serial, Label("platformalteration2", "ocp-required"), func() {
+//nolint:lll
+var _ = Describe("platform-alteration-hugepages-1g-only", Serial, Label("platformalteration2", "ocp-required"), globalhelper.RequiresCapability(globalhelper.CapabilityHugepages1Gi), func() {
 	var (
 		randomNamespace          string
 		randomReportDir          string
@@ -35,16 +36,6 @@ var _ = Describe("platform-alteration-hugepages-1g-only", Serial, Label("platfor
 			[]string{},
 			[]string{}, randomCertsuiteConfigDir)
 		Expect(err).ToNot(HaveOccurred())
-
-		if globalhelper.IsKindCluster() {
-			Skip("Hugepages are not supported in Kind clusters")
-		}
-
-		By("Check if nodes have hugepages enabled")
-
-		if !globalhelper.NodesHaveHugePagesEnabled("1Gi") {
-			Skip("Hugepages configuration is not enabled on the cluster")
-		}
 	})
 
 	AfterEach(func() {
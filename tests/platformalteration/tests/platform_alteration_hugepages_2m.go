@@ -14,7 +14,8 @@ import (
 	. "github.com/onsi/gomega"
 )
 
-var _ = Describe("platform-alteration-hugepages-2m-only", Serial, Label("platformalteration2", "ocp-required"), func() {
+//nolint:lll
+var _ = Describe("platform-alteration-hugepages-2m-only", Serial, Label("platformalteration2", "ocp-required"), globalhelper.RequiresCapability(globalhelper.CapabilityHugepages2Mi), func() {
 	var (
 		randomNamespace          string
 		randomReportDir          string
@@ -35,16 +36,6 @@ var _ = Describe("platform-alteration-hugepages-2m-only", Serial, Label("platfor
 			[]string{},
 			[]string{}, randomCertsuiteConfigDir)
 		Expect(err).ToNot(HaveOccurred())
-
-		if globalhelper.IsKindCluster() {
-			Skip("Hugepages are not supported in Kind clusters")
-		}
-
-		By("Check if nodes have hugepages enabled")
-
-		if !globalhelper.NodesHaveHugePagesEnabled("2Mi") {
-			Skip("Hugepages configuration is not enabled on the cluster")
-		}
 	})
 
 	AfterEach(func() {
@@ -52,8 +43,7 @@ var _ = Describe("platform-alteration-hugepages-2m-only", Serial, Label("platfor
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 55865
-	It("One deployment, one pod with 2Mi hugepages", func() {
+	It("One deployment, one pod with 2Mi hugepages", globalhelper.PolarionID("55865"), func() {
 		By("Define deployment")
 		dep := deployment.DefineDeployment(tsparams.TestDeploymentName, randomNamespace,
 			tsparams.SampleWorkloadImage, tsparams.CertsuiteTargetPodLabels)
@@ -92,8 +82,7 @@ var _ = Describe("platform-alteration-hugepages-2m-only", Serial, Label("platfor
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55866
-	It("One pod with 2Mi hugepages", func() {
+	It("One pod with 2Mi hugepages", globalhelper.PolarionID("55866"), func() {
 		By("Define pod with 2Mi hugepages")
 		puta := pod.DefinePod(tsparams.TestPodName, randomNamespace, tsparams.SampleWorkloadImage,
 			tsparams.CertsuiteTargetPodLabels)
@@ -125,8 +114,7 @@ var _ = Describe("platform-alteration-hugepages-2m-only", Serial, Label("platfor
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55867
-	It("One deployment, one pod, two containers, only one with 2Mi hugepages", func() {
+	It("One deployment, one pod, two containers, only one with 2Mi hugepages", globalhelper.PolarionID("55867"), func() {
 		By("Define deployment")
 		dep := deployment.DefineDeployment(tsparams.TestDeploymentName, randomNamespace,
 			tsparams.SampleWorkloadImage, tsparams.CertsuiteTargetPodLabels)
@@ -166,8 +154,7 @@ var _ = Describe("platform-alteration-hugepages-2m-only", Serial, Label("platfor
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 55868
-	It("One pod, two containers, one with 2Mi hugepages, other with 1Gi [negative]", func() {
+	It("One pod, two containers, one with 2Mi hugepages, other with 1Gi [negative]", globalhelper.PolarionID("55868"), func() {
 		By("Define pod")
 		put := pod.DefinePod(tsparams.TestDeploymentName, randomNamespace,
 			tsparams.SampleWorkloadImage, tsparams.CertsuiteTargetPodLabels)
@@ -14,7 +14,8 @@ import (
 	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/utils/daemonset"
 )
 
-var _ = Describe("platform-alteration-hugepages-config", Serial, Label("platformalteration3", "ocp-required"), func() {
+//nolint:lll
+var _ = Describe("platform-alteration-hugepages-config", Serial, Label("platformalteration3", "ocp-required"), globalhelper.RequiresCapability(globalhelper.CapabilityMCO), func() {
 	var (
 		randomNamespace          string
 		randomReportDir          string
@@ -35,17 +36,6 @@ var _ = Describe("platform-alteration-hugepages-config", Serial, Label("platform
 			[]string{},
 			[]string{}, randomCertsuiteConfigDir)
 		Expect(err).ToNot(HaveOccurred())
-
-		if globalhelper.IsKindCluster() {
-			Skip("Hugepages are not supported in Kind clusters")
-		}
-
-		By("Verify MCO is healthy and accessible")
-
-		mcoHealthy, err := globalhelper.IsMCOHealthy()
-		if err != nil || !mcoHealthy {
-			Skip("MCO is not healthy or accessible on this cluster - skipping hugepages config tests")
-		}
 	})
 
 	AfterEach(func() {
@@ -53,8 +43,7 @@ var _ = Describe("platform-alteration-hugepages-config", Serial, Label("platform
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 51308
-	It("unchanged configuration", func() {
+	It("unchanged configuration", globalhelper.PolarionID("51308"), func() {
 		crdExists, err := crd.EnsureCrdExists(tsparams.PerformanceProfileCrd)
 		Expect(err).ToNot(HaveOccurred())
 
@@ -74,8 +63,7 @@ var _ = Describe("platform-alteration-hugepages-config", Serial, Label("platform
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51309
-	It("Change Hugepages config manually [negative]", func() {
+	It("Change Hugepages config manually [negative]", globalhelper.PolarionID("51309"), func() {
 		crdExists, err := crd.EnsureCrdExists(tsparams.PerformanceProfileCrd)
 		Expect(err).ToNot(HaveOccurred())
 
@@ -43,8 +43,7 @@ var _ = Describe("platform-alteration-is-redhat-release", Label("platformalterat
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 51319
-	It("One deployment, one pod, several containers, all running Red Hat release", func() {
+	It("One deployment, one pod, several containers, all running Red Hat release", globalhelper.PolarionID("51319"), func() {
 		By("Define deployment")
 		deployment := deployment.DefineDeployment(tsparams.TestDeploymentName, randomNamespace,
 			tsparams.SampleWorkloadImage, tsparams.CertsuiteTargetPodLabels)
@@ -91,8 +90,7 @@ var _ = Describe("platform-alteration-is-redhat-release", Label("platformalterat
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51320
-	It("One daemonSet that is running Red Hat release", func() {
+	It("One daemonSet that is running Red Hat release", globalhelper.PolarionID("51320"), func() {
 		By("Define daemonSet")
 		daemonSet := daemonset.DefineDaemonSet(randomNamespace,
 			tsparams.SampleWorkloadImage,
@@ -140,8 +138,8 @@ var _ = Describe("platform-alteration-is-redhat-release", Label("platformalterat
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51321
-	It("One deployment, one pod, 2 containers, one running Red Hat release, other is not [negative]", func() {
+	It("One deployment, one pod, 2 containers, one running Red Hat release, other is not "+
+		"[negative]", globalhelper.PolarionID("51321"), func() {
 		By("Define deployment")
 		dep := tshelper.DefineDeploymentWithNonUBIContainer(randomNamespace)
 
@@ -188,8 +186,7 @@ var _ = Describe("platform-alteration-is-redhat-release", Label("platformalterat
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51326
-	It("One statefulSet, one pod that is not running Red Hat release [negative]", func() {
+	It("One statefulSet, one pod that is not running Red Hat release [negative]", globalhelper.PolarionID("51326"), func() {
 		By("Define statefulSet")
 		statefulSet := tshelper.DefineStatefulSetWithNonUBIContainer(randomNamespace)
 
@@ -45,8 +45,7 @@ var _ = Describe("platform-alteration-is-selinux-enforcing", Label("platformalte
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 51310
-	It("SELinux is enforcing on all nodes", func() {
+	It("SELinux is enforcing on all nodes", globalhelper.PolarionID("51310"), func() {
 		daemonSet := daemonset.DefineDaemonSet(randomNamespace, tsparams.SampleWorkloadImage,
 			tsparams.CertsuiteTargetPodLabels, tsparams.TestDaemonSetName)
 		daemonset.RedefineWithPrivilegedContainer(daemonSet)
@@ -96,8 +95,7 @@ var _ = Describe("platform-alteration-is-selinux-enforcing", Label("platformalte
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51311
-	It("SELinux is permissive on one node [negative]", func() {
+	It("SELinux is permissive on one node [negative]", globalhelper.PolarionID("51311"), func() {
 		if globalhelper.IsKindCluster() {
 			Skip("Kind cluster does not support SELinux")
 		}
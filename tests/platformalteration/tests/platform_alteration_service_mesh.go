@@ -78,8 +78,7 @@ var _ = Describe("platform-alteration-service-mesh-usage-installed", Ordered, La
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 56594
-	It("istio is installed", func() {
+	It("istio is installed", globalhelper.PolarionID("56594"), func() {
 		By("Define a test pod with istio container")
 		put := pod.DefinePod(tsparams.TestPodName, randomNamespace, tsparams.SampleWorkloadImage,
 			tsparams.CertsuiteTargetPodLabels)
@@ -109,8 +108,7 @@ var _ = Describe("platform-alteration-service-mesh-usage-installed", Ordered, La
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56596
-	It("istio is installed but proxy containers does not exist [negative]", func() {
+	It("istio is installed but proxy containers does not exist [negative]", globalhelper.PolarionID("56596"), func() {
 		By("Define a test pod without istio container")
 		put := pod.DefinePod(tsparams.TestPodName, randomNamespace, tsparams.SampleWorkloadImage,
 			tsparams.CertsuiteTargetPodLabels)
@@ -139,8 +137,7 @@ var _ = Describe("platform-alteration-service-mesh-usage-installed", Ordered, La
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 56597
-	It("istio is installed but proxy container exist on one pod only [negative]", func() {
+	It("istio is installed but proxy container exist on one pod only [negative]", globalhelper.PolarionID("56597"), func() {
 		By("Define first pod with istio container")
 		put := pod.DefinePod(tsparams.TestPodName, randomNamespace, tsparams.SampleWorkloadImage,
 			tsparams.CertsuiteTargetPodLabels)
@@ -207,8 +204,7 @@ var _ = Describe("platform-alteration-service-mesh-usage-uninstalled", Serial, L
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 56595
-	It("istio is not installed", func() {
+	It("istio is not installed", globalhelper.PolarionID("56595"), func() {
 		By("Check if Istio resource exists")
 		gvr := schema.GroupVersionResource{Group: "install.istio.io", Version: "v1alpha1", Resource: "istiooperators"}
 
@@ -47,8 +47,7 @@ var _ = Describe("platform-alteration-sysctl-config", Label("platformalteration4
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 51302
-	It("unchanged sysctl config", func() {
+	It("unchanged sysctl config", globalhelper.PolarionID("51302"), func() {
 		By("Create daemonSet")
 		daemonSet := daemonset.DefineDaemonSet(randomNamespace, tsparams.SampleWorkloadImage,
 			tsparams.CertsuiteTargetPodLabels, tsparams.TestDaemonSetName)
@@ -87,8 +86,7 @@ var _ = Describe("platform-alteration-sysctl-config", Label("platformalteration4
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51332
-	It("change sysctl config using MCO", func() {
+	It("change sysctl config using MCO", globalhelper.PolarionID("51332"), func() {
 		By("Create daemonSet")
 		daemonSet := daemonset.DefineDaemonSet(randomNamespace, tsparams.SampleWorkloadImage,
 			tsparams.CertsuiteTargetPodLabels, tsparams.TestDaemonSetName)
@@ -41,8 +41,7 @@ var _ = Describe("platform-alteration-tainted-node-kernel", Serial, Label("platf
 			randomReportDir, randomCertsuiteConfigDir, tsparams.WaitingTime)
 	})
 
-	// 51389
-	It("Untainted node", func() {
+	It("Untainted node", globalhelper.PolarionID("51389"), func() {
 		// Nodes may be legitimately tainted by cluster tooling (performance-addon-operator,
 		// DPDK drivers, out-of-tree modules, etc.), so the certsuite result depends on
 		// the cluster's actual kernel taint state. Accept any valid certsuite outcome.
@@ -59,8 +58,7 @@ var _ = Describe("platform-alteration-tainted-node-kernel", Serial, Label("platf
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	// 51390
-	It("Tainted node [negative]", func() {
+	It("Tainted node [negative]", globalhelper.PolarionID("51390"), func() {
 		if globalhelper.IsKindCluster() {
 			Skip("Tainting a node not support on Kind cluster, skipping...")
 		}
@@ -63,9 +63,11 @@ func New(kubeconfig string) *ClientSet {
 		klog.V(4).Infof("Loading kube client config from path %q", kubeconfig)
 		config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
 	} else {
-		klog.V(4).Infof("Using in-cluster kube client config")
+		// Like kubectl: ~/.kube/config, or the in-cluster config when it does not exist.
+		klog.V(4).Infof("Loading kube client config with the default loading rules")
 
-		config, err = rest.InClusterConfig()
+		config, err = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+			clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{}).ClientConfig()
 	}
 
 	if err != nil {
@@ -19,6 +19,9 @@ import (
 const (
 	// FileConfigPath path to config file.
 	FileConfigPath = "config/config.yaml"
+	// defaultContainerEngine is the container engine used when neither the configuration, env vars
+	// nor the profile set one.
+	defaultContainerEngine = "docker"
 )
 
 // Config type keeps general GetConfiguration().
@@ -37,12 +40,33 @@ type Config struct {
 		CertsuiteImage            string `yaml:"certsuite_image" envconfig:"CERTSUITE_IMAGE"`
 		CertsuiteImageTag         string `yaml:"certsuite_image_tag" envconfig:"CERTSUITE_IMAGE_TAG"`
 		DisableIntrusiveTests     string `yaml:"disable_intrusive_tests" envconfig:"DISABLE_INTRUSIVE_TESTS"`
-		ContainerEngine           string `default:"docker" yaml:"container_engine" envconfig:"CONTAINER_ENGINE"`
+		ContainerEngine           string `yaml:"container_engine" envconfig:"CONTAINER_ENGINE"`
 		UseBinary                 string `default:"false" yaml:"use_binary" envconfig:"USE_BINARY"`
+		// Launcher selects the registered launcher used to run certsuite (binary, container, fake...).
+		// When empty, UseBinary decides between the binary and the container launchers.
+		Launcher string `yaml:"launcher" envconfig:"CERTSUITE_LAUNCHER"`
+		// CertsuiteJobNamespace is the namespace where the job launcher runs certsuite inside the cluster.
+		CertsuiteJobNamespace string `default:"certsuite-qe-runner" yaml:"job_namespace" envconfig:"CERTSUITE_JOB_NAMESPACE"`
+		// CertsuiteBinaryCacheDir holds the certsuite binaries built from CertsuiteRepoPath, keyed by commit.
+		// Defaults to certsuite-qe in the user cache directory.
+		CertsuiteBinaryCacheDir string `yaml:"certsuite_binary_cache_dir" envconfig:"CERTSUITE_BINARY_CACHE_DIR"`
+		// CertsuiteVersionMatrix lists extra certsuite image tags, or binary paths, every test case is run
+		// with. Their results are compared with the ones of CertsuiteImageTag or of the repo binary.
+		CertsuiteVersionMatrix []string `yaml:"certsuite_version_matrix" envconfig:"CERTSUITE_VERSION_MATRIX"`
 		// EnableInfraTolerations enables tolerations for infrastructure taints
 		// (disk-pressure, memory-pressure, etc.) to improve test reliability in CI environments
 		EnableInfraTolerations string `default:"true" yaml:"enable_infrastructure_tolerations" envconfig:"ENABLE_INFRASTRUCTURE_TOLERATIONS"`
+		// Profile names the environment profile applied over these settings, "auto" to detect it
+		// from the cluster. When empty, no profile is applied.
+		Profile string `yaml:"profile" envconfig:"CERTSUITE_QE_PROFILE"`
+		// TimeoutMultiplier scales the certsuite launch timeouts, usually set by the profile.
+		TimeoutMultiplier float64 `yaml:"timeout_multiplier" envconfig:"TIMEOUT_MULTIPLIER"`
 	} `yaml:"general"`
+	// Profiles overrides the settings of the built-in profiles, or adds new ones.
+	Profiles map[string]Profile `yaml:"profiles" ignored:"true"`
+	// fileSettings are the yaml keys of the general settings set in the configuration file, which
+	// profiles do not override.
+	fileSettings map[string]bool
 }
 
 // DefineClients sets client and return it's instance.
@@ -64,7 +88,7 @@ func NewConfig() (*Config, error) {
 
 	confFile, err := checkFileExists(baseDir, FileConfigPath)
 	if err != nil {
-		klog.Fatal(err)
+		return nil, err
 	}
 
 	err = readFile(&conf, confFile)
@@ -79,6 +103,18 @@ func NewConfig() (*Config, error) {
 		return nil, fmt.Errorf("failed to read env vars: %w", err)
 	}
 
+	// Profiles, the auto one included, replace it: it is neither in the file nor an env var.
+	if conf.General.ContainerEngine == "" {
+		conf.General.ContainerEngine = defaultContainerEngine
+	}
+
+	if conf.General.Profile != "" && !conf.ProfileIsAuto() {
+		err = conf.ApplyProfile(conf.General.Profile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply profile: %w", err)
+		}
+	}
+
 	err = conf.deployCertsuiteConfigDir(confFile)
 	if err != nil {
 		return nil, fmt.Errorf("failed to deploy certsuite config dir: %w", err)
@@ -89,16 +125,10 @@ func NewConfig() (*Config, error) {
 		return nil, fmt.Errorf("failed to deploy certsuite report dir: %w", err)
 	}
 
+	// Validate reports the missing docker config, with the other configuration problems.
 	err = conf.makeDockerConfig()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create docker config: %w", err)
-	}
-
-	if conf.General.UseBinary == "true" {
-		conf.General.CertsuiteRepoPath, err = conf.defineCertsuiteRepoPath()
-		if err != nil {
-			klog.Fatalf("Failed to define certsuite repo path: %v", err)
-		}
+		klog.ErrorS(err, "failed to create docker config")
 	}
 
 	return &conf, nil
@@ -118,6 +148,19 @@ func (c *Config) DebugCertsuite() (bool, error) {
 	return false, nil
 }
 
+// LauncherName returns the name of the launcher used to run certsuite.
+func (c *Config) LauncherName() string {
+	if c.General.Launcher != "" {
+		return c.General.Launcher
+	}
+
+	if c.General.UseBinary == "true" {
+		return globalparameters.BinaryLauncherName
+	}
+
+	return globalparameters.ContainerLauncherName
+}
+
 // CreateLogFile creates log file for testSuite.
 func (c *Config) CreateLogFile(testSuite string, tcName string) *os.File {
 	folderPath := filepath.Join(c.General.ReportDirAbsPath, "Debug", testSuite, tcName)
@@ -153,28 +196,31 @@ func (c *Config) GetReportPath(file string) string {
 	return fmt.Sprintf("%s.xml", filepath.Join(c.General.ReportDirAbsPath, reportFileName))
 }
 
-func (c *Config) defineCertsuiteRepoPath() (string, error) {
-	if c.General.CertsuiteRepoPath == "" {
-		return "", fmt.Errorf("CERTSUITE_REPO_PATH env variable is not set. Please export CERTSUITE_REPO_PATH")
-	}
-
-	return c.General.CertsuiteRepoPath, nil
-}
-
 func readFile(cfg *Config, cfgFile string) error {
-	openedCnfFile, err := os.Open(cfgFile)
+	content, err := os.ReadFile(cfgFile)
 	if err != nil {
 		return fmt.Errorf("failed to open cfg file: %w", err)
 	}
-	defer openedCnfFile.Close()
 
-	decoder := yaml.NewDecoder(openedCnfFile)
+	err = yaml.Unmarshal(content, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to decode config file: %w", err)
+	}
+
+	var settings struct {
+		General map[string]interface{} `yaml:"general"`
+	}
 
-	err = decoder.Decode(&cfg)
+	err = yaml.Unmarshal(content, &settings)
 	if err != nil {
 		return fmt.Errorf("failed to decode config file: %w", err)
 	}
 
+	cfg.fileSettings = map[string]bool{}
+	for key := range settings.General {
+		cfg.fileSettings[key] = true
+	}
+
 	return nil
 }
 
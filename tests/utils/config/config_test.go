@@ -3,6 +3,7 @@ package config
 import (
 	"testing"
 
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,3 +28,25 @@ func TestDebugCertsuite(t *testing.T) {
 		})
 	}
 }
+
+func TestLauncherName(t *testing.T) {
+	testCases := []struct {
+		launcher  string
+		useBinary string
+		expected  string
+	}{
+		{"", "false", globalparameters.ContainerLauncherName},
+		{"", "true", globalparameters.BinaryLauncherName},
+		{globalparameters.FakeLauncherName, "true", globalparameters.FakeLauncherName},
+		{globalparameters.ContainerLauncherName, "true", globalparameters.ContainerLauncherName},
+	}
+
+	for _, testCase := range testCases {
+		var c Config
+
+		c.General.Launcher = testCase.launcher
+		c.General.UseBinary = testCase.useBinary
+
+		assert.Equal(t, testCase.expected, c.LauncherName())
+	}
+}
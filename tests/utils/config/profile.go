@@ -0,0 +1,172 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"sort"
+	"time"
+
+	klog "k8s.io/klog/v2"
+)
+
+// Names of the environment profiles. ProfileAuto detects the profile from the cluster.
+const (
+	ProfileAuto   = "auto"
+	ProfileKind   = "kind"
+	ProfileCRC    = "crc"
+	ProfileOCPSNO = "ocp-sno"
+	ProfileOCP    = "ocp"
+	ProfileK8s    = "k8s"
+)
+
+// Profile holds the settings of an environment. It only fills the general settings left unset:
+// empty fields, and the settings set in the configuration file or by environment variables, keep
+// the general configuration values.
+type Profile struct {
+	CnfNodeLabel           string `yaml:"cnf_worker_label" envconfig:"ROLE_WORKER_CNF"`
+	WorkerNodeLabel        string `yaml:"worker_label" envconfig:"ROLE_WORKER"`
+	CertsuiteImage         string `yaml:"certsuite_image" envconfig:"CERTSUITE_IMAGE"`
+	CertsuiteImageTag      string `yaml:"certsuite_image_tag" envconfig:"CERTSUITE_IMAGE_TAG"`
+	DisableIntrusiveTests  string `yaml:"disable_intrusive_tests" envconfig:"DISABLE_INTRUSIVE_TESTS"`
+	ContainerEngine        string `yaml:"container_engine" envconfig:"CONTAINER_ENGINE"`
+	EnableInfraTolerations string `yaml:"enable_infrastructure_tolerations" envconfig:"ENABLE_INFRASTRUCTURE_TOLERATIONS"`
+	// TimeoutMultiplier scales the certsuite launch timeouts and the timeouts given to ScaleTimeout,
+	// for slow environments. Zero keeps them unchanged.
+	TimeoutMultiplier float64 `yaml:"timeout_multiplier" envconfig:"TIMEOUT_MULTIPLIER"`
+}
+
+// DefaultProfiles are the built-in profiles, the profiles section of the configuration file
+// overrides their fields.
+var DefaultProfiles = map[string]Profile{
+	ProfileKind: {
+		WorkerNodeLabel:       "node-role.kubernetes.io/worker",
+		ContainerEngine:       "docker",
+		DisableIntrusiveTests: "false",
+	},
+	ProfileCRC: {
+		WorkerNodeLabel:       "node-role.kubernetes.io/worker",
+		ContainerEngine:       "podman",
+		DisableIntrusiveTests: "true",
+		TimeoutMultiplier:     2,
+	},
+	ProfileOCPSNO: {
+		WorkerNodeLabel:       "node-role.kubernetes.io/worker",
+		ContainerEngine:       "podman",
+		DisableIntrusiveTests: "true",
+		TimeoutMultiplier:     1.5,
+	},
+	ProfileOCP: {
+		WorkerNodeLabel:       "node-role.kubernetes.io/worker",
+		CnfNodeLabel:          "node-role.kubernetes.io/worker-cnf",
+		ContainerEngine:       "podman",
+		DisableIntrusiveTests: "false",
+	},
+	ProfileK8s: {
+		WorkerNodeLabel:       "node-role.kubernetes.io/worker",
+		ContainerEngine:       "docker",
+		DisableIntrusiveTests: "false",
+	},
+}
+
+// ProfileNames returns the names of the known profiles.
+func (c *Config) ProfileNames() []string {
+	names := []string{}
+
+	for name := range DefaultProfiles {
+		names = append(names, name)
+	}
+
+	for name := range c.Profiles {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// GetProfile returns the settings of a profile: the built-in ones overridden by the profiles
+// section of the configuration file.
+func (c *Config) GetProfile(name string) (Profile, error) {
+	profile, builtIn := DefaultProfiles[name]
+	override, configured := c.Profiles[name]
+
+	if !builtIn && !configured {
+		return Profile{}, fmt.Errorf("unknown profile %q, known profiles: %v", name, c.ProfileNames())
+	}
+
+	mergeProfile(&profile, override)
+
+	return profile, nil
+}
+
+// ApplyProfile sets the settings of a profile in the general configuration, except the ones set in
+// the configuration file or by environment variables, and records it as the active profile.
+func (c *Config) ApplyProfile(name string) error {
+	profile, err := c.GetProfile(name)
+	if err != nil {
+		return err
+	}
+
+	general := reflect.ValueOf(&c.General).Elem()
+	profileValue := reflect.ValueOf(profile)
+	profileType := profileValue.Type()
+
+	for i := range profileType.NumField() {
+		field := profileType.Field(i)
+
+		value := profileValue.Field(i)
+		if value.IsZero() {
+			continue
+		}
+
+		if _, set := os.LookupEnv(field.Tag.Get("envconfig")); set {
+			klog.V(5).Infof("Profile %s %s not applied, set by env var", name, field.Name)
+
+			continue
+		}
+
+		if c.fileSettings[field.Tag.Get("yaml")] {
+			klog.V(5).Infof("Profile %s %s not applied, set in the configuration file", name, field.Name)
+
+			continue
+		}
+
+		general.FieldByName(field.Name).Set(value)
+	}
+
+	c.General.Profile = name
+	klog.V(4).Infof("Using the %s profile", name)
+
+	return nil
+}
+
+// ProfileIsAuto returns true when the profile has to be detected from the cluster.
+func (c *Config) ProfileIsAuto() bool {
+	return c.General.Profile == ProfileAuto
+}
+
+// ScaleTimeout returns the timeout scaled by the active profile timeout multiplier.
+func (c *Config) ScaleTimeout(timeout time.Duration) time.Duration {
+	if c.General.TimeoutMultiplier <= 0 {
+		return timeout
+	}
+
+	return time.Duration(float64(timeout) * c.General.TimeoutMultiplier)
+}
+
+// mergeProfile sets the non-empty fields of override in profile.
+func mergeProfile(profile *Profile, override Profile) {
+	profileValue := reflect.ValueOf(profile).Elem()
+	overrideValue := reflect.ValueOf(override)
+
+	for i := range overrideValue.NumField() {
+		if !overrideValue.Field(i).IsZero() {
+			profileValue.Field(i).Set(overrideValue.Field(i))
+		}
+	}
+}
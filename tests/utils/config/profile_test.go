@@ -0,0 +1,109 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyProfile(t *testing.T) {
+	t.Setenv("CONTAINER_ENGINE", "")
+
+	var c Config
+
+	c.General.ContainerEngine = "docker"
+	c.General.DisableIntrusiveTests = "false"
+	c.General.CertsuiteImageTag = "latest"
+	c.Profiles = map[string]Profile{ProfileCRC: {TimeoutMultiplier: 3}}
+
+	assert.Nil(t, c.ApplyProfile(ProfileCRC))
+
+	assert.Equal(t, ProfileCRC, c.General.Profile)
+	// Set by env var, even empty.
+	assert.Equal(t, "docker", c.General.ContainerEngine)
+	assert.Equal(t, "true", c.General.DisableIntrusiveTests)
+	// Not set by the profile.
+	assert.Equal(t, "latest", c.General.CertsuiteImageTag)
+	// Overridden in the configuration file.
+	assert.Equal(t, 3*time.Minute, c.ScaleTimeout(time.Minute))
+}
+
+func TestApplyProfileKeepsFileSettings(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	assert.Nil(t, os.WriteFile(configFile, []byte("general:\n  container_engine: docker\n"+
+		"  disable_intrusive_tests: false\n"), 0600))
+
+	var c Config
+
+	assert.Nil(t, readFile(&c, configFile))
+	assert.Nil(t, c.ApplyProfile(ProfileCRC))
+
+	// Set in the configuration file.
+	assert.Equal(t, "docker", c.General.ContainerEngine)
+	assert.Equal(t, "false", c.General.DisableIntrusiveTests)
+	// Not set in the configuration file.
+	assert.Equal(t, "node-role.kubernetes.io/worker", c.General.WorkerNodeLabel)
+	assert.Equal(t, 2*time.Minute, c.ScaleTimeout(time.Minute))
+}
+
+func TestApplyProfileUnknown(t *testing.T) {
+	var c Config
+
+	c.Profiles = map[string]Profile{"lab": {ContainerEngine: "podman"}}
+
+	assert.ErrorContains(t, c.ApplyProfile("unknown"), "unknown profile \"unknown\"")
+	assert.Contains(t, c.ProfileNames(), "lab")
+	assert.Contains(t, c.ProfileNames(), ProfileOCPSNO)
+
+	assert.Nil(t, c.ApplyProfile("lab"))
+	assert.Equal(t, "podman", c.General.ContainerEngine)
+	assert.Equal(t, time.Minute, c.ScaleTimeout(time.Minute))
+}
+
+func TestNewConfigProfile(t *testing.T) {
+	unsetEnv(t, "CERTSUITE_QE_PROFILE", "CONTAINER_ENGINE", "DISABLE_INTRUSIVE_TESTS", "TIMEOUT_MULTIPLIER")
+
+	c, err := NewConfig()
+	assert.Nil(t, err)
+	// Left to the profile in config/config.yaml, without one.
+	assert.Equal(t, "docker", c.General.ContainerEngine)
+	assert.Equal(t, "", c.General.DisableIntrusiveTests)
+
+	t.Setenv("CERTSUITE_QE_PROFILE", ProfileOCPSNO)
+
+	c, err = NewConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, ProfileOCPSNO, c.General.Profile)
+	assert.Equal(t, "true", c.General.DisableIntrusiveTests)
+	assert.Equal(t, "podman", c.General.ContainerEngine)
+	assert.Equal(t, 3*time.Minute, c.ScaleTimeout(2*time.Minute))
+	// Set in config/config.yaml.
+	assert.Equal(t, "node-role.kubernetes.io/worker-cnf", c.General.CnfNodeLabel)
+
+	t.Setenv("CONTAINER_ENGINE", "docker")
+
+	c, err = NewConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "docker", c.General.ContainerEngine)
+
+	t.Setenv("CERTSUITE_QE_PROFILE", ProfileAuto)
+
+	// The auto profile is detected by globalhelper once a cluster is reachable.
+	c, err = NewConfig()
+	assert.Nil(t, err)
+	assert.True(t, c.ProfileIsAuto())
+}
+
+// unsetEnv unsets env vars for the duration of the test.
+func unsetEnv(t *testing.T, names ...string) {
+	t.Helper()
+
+	for _, name := range names {
+		// Restores the original value at the end of the test.
+		t.Setenv(name, "")
+		assert.Nil(t, os.Unsetenv(name))
+	}
+}
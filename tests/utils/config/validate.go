@@ -0,0 +1,225 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// ProblemKind tells what is wrong in a configuration.
+type ProblemKind string
+
+const (
+	// ProblemInvalidBool is a boolean setting that is neither true nor false.
+	ProblemInvalidBool ProblemKind = "invalid-bool"
+	// ProblemInvalidValue is a setting with a value out of its allowed range.
+	ProblemInvalidValue ProblemKind = "invalid-value"
+	// ProblemUnknownContainerEngine is a container engine other than docker or podman.
+	ProblemUnknownContainerEngine ProblemKind = "unknown-container-engine"
+	// ProblemUnknownLauncher is a launcher name no launcher is registered with.
+	ProblemUnknownLauncher ProblemKind = "unknown-launcher"
+	// ProblemMissingDockerConfig is a docker config file the certsuite container cannot mount.
+	ProblemMissingDockerConfig ProblemKind = "missing-docker-config"
+	// ProblemMissingBinary is an executable or a source tree the launcher needs.
+	ProblemMissingBinary ProblemKind = "missing-binary"
+	// ProblemMissingImage is an empty certsuite image or image tag.
+	ProblemMissingImage ProblemKind = "missing-image"
+	// ProblemImageUnreachable is a certsuite image the container engine finds neither locally nor in its registry.
+	ProblemImageUnreachable ProblemKind = "image-unreachable"
+	// ProblemKubeconfigUnreadable is a kubeconfig that is not set, cannot be read or parsed.
+	ProblemKubeconfigUnreadable ProblemKind = "kubeconfig-unreadable"
+)
+
+// ContainerEngines are the container engines the container launcher supports.
+var ContainerEngines = []string{"docker", "podman"}
+
+// Problem is a configuration setting certsuite-qe cannot run with.
+type Problem struct {
+	Kind ProblemKind
+	// Setting is the env var of the setting.
+	Setting string
+	Value   string
+	// Hint tells how to fix the problem.
+	Hint string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: %s=%q, %s", p.Kind, p.Setting, p.Value, p.Hint)
+}
+
+// ValidationError lists the problems of a configuration.
+type ValidationError struct {
+	Problems []Problem
+}
+
+func (e *ValidationError) Error() string {
+	lines := make([]string, 0, len(e.Problems))
+	for _, problem := range e.Problems {
+		lines = append(lines, "  - "+problem.String())
+	}
+
+	return fmt.Sprintf("%d configuration problem(s):\n%s", len(e.Problems), strings.Join(lines, "\n"))
+}
+
+// Validate checks the settings the selected launcher needs, and returns a *ValidationError
+// listing all the problems found. Launcher names are checked by globalhelper, where the
+// launchers are registered.
+func (c *Config) Validate() error {
+	var problems []Problem
+
+	problems = append(problems, c.validateBools()...)
+	problems = append(problems, c.validateLauncher()...)
+	problems = append(problems, validateKubeconfig()...)
+
+	if c.General.TimeoutMultiplier < 0 {
+		problems = append(problems, Problem{ProblemInvalidValue, "TIMEOUT_MULTIPLIER",
+			strconv.FormatFloat(c.General.TimeoutMultiplier, 'g', -1, 64), "set a positive multiplier"})
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+
+	return nil
+}
+
+func (c *Config) validateBools() []Problem {
+	var problems []Problem
+
+	for _, setting := range []struct {
+		envVar string
+		value  string
+	}{
+		{"USE_BINARY", c.General.UseBinary},
+		{"DEBUG_CERTSUITE", c.General.DebugCertsuite},
+		{"DISABLE_INTRUSIVE_TESTS", c.General.DisableIntrusiveTests},
+		{"ENABLE_INFRASTRUCTURE_TOLERATIONS", c.General.EnableInfraTolerations},
+	} {
+		if setting.value == "" {
+			continue
+		}
+
+		if _, err := strconv.ParseBool(setting.value); err != nil {
+			problems = append(problems, Problem{ProblemInvalidBool, setting.envVar, setting.value, "set true or false"})
+		}
+	}
+
+	return problems
+}
+
+func (c *Config) validateLauncher() []Problem {
+	switch c.LauncherName() {
+	case globalparameters.BinaryLauncherName:
+		return c.validateBinaryLauncher()
+	case globalparameters.ContainerLauncherName:
+		return c.validateContainerLauncher()
+	case globalparameters.JobLauncherName:
+		return append(c.validateDockerConfig(), c.validateImage()...)
+	default:
+		return nil
+	}
+}
+
+func (c *Config) validateBinaryLauncher() []Problem {
+	repoPath := c.General.CertsuiteRepoPath
+	if repoPath == "" {
+		return []Problem{{ProblemMissingBinary, "CERTSUITE_REPO_PATH", repoPath,
+			"export the absolute path of a certsuite clone to run the binary launcher"}}
+	}
+
+	if info, err := os.Stat(repoPath); err != nil || !info.IsDir() {
+		return []Problem{{ProblemMissingBinary, "CERTSUITE_REPO_PATH", repoPath, "the certsuite clone does not exist"}}
+	}
+
+	// A certsuite binary already built in the clone is run without the go toolchain.
+	binaryPath := filepath.Join(repoPath, c.General.CertsuiteEntryPointBinary)
+	if info, err := os.Stat(binaryPath); err == nil && info.Mode().IsRegular() && info.Mode().Perm()&0o111 != 0 {
+		return nil
+	}
+
+	if _, err := exec.LookPath("go"); err != nil {
+		return []Problem{{ProblemMissingBinary, "CERTSUITE_REPO_PATH", repoPath,
+			"no certsuite binary is built in the clone and the go toolchain is needed to build it, " +
+				"install it or use the container launcher"}}
+	}
+
+	return nil
+}
+
+func (c *Config) validateContainerLauncher() []Problem {
+	var problems []Problem
+
+	engine := c.General.ContainerEngine
+
+	if !slices.Contains(ContainerEngines, engine) {
+		problems = append(problems, Problem{ProblemUnknownContainerEngine, "CONTAINER_ENGINE", engine,
+			"use one of " + strings.Join(ContainerEngines, ", ")})
+	} else if _, err := exec.LookPath(engine); err != nil {
+		problems = append(problems, Problem{ProblemMissingBinary, "CONTAINER_ENGINE", engine,
+			engine + " is not in PATH, install it or select another engine"})
+	}
+
+	problems = append(problems, c.validateDockerConfig()...)
+
+	return append(problems, c.validateImage()...)
+}
+
+// validateDockerConfig checks the docker config certsuite gets for the preflight checks.
+func (c *Config) validateDockerConfig() []Problem {
+	dockerConfig := filepath.Join(c.General.DockerConfigDir, "config")
+	if _, err := os.Stat(dockerConfig); err != nil {
+		return []Problem{{ProblemMissingDockerConfig, "DOCKER_CONFIG_DIR", c.General.DockerConfigDir,
+			"the directory must be writable, certsuite-qe creates the config file the certsuite container mounts"}}
+	}
+
+	return nil
+}
+
+// validateImage checks the certsuite image the container and job launchers run.
+func (c *Config) validateImage() []Problem {
+	var problems []Problem
+
+	if c.General.CertsuiteImage == "" {
+		problems = append(problems, Problem{ProblemMissingImage, "CERTSUITE_IMAGE", "", "set the certsuite image repository"})
+	}
+
+	if c.General.CertsuiteImageTag == "" {
+		problems = append(problems, Problem{ProblemMissingImage, "CERTSUITE_IMAGE_TAG", "", "set the certsuite image tag"})
+	}
+
+	return problems
+}
+
+func validateKubeconfig() []Problem {
+	kubeconfig := os.Getenv("KUBECONFIG")
+	if kubeconfig == "" {
+		// The client falls back to the in-cluster configuration.
+		if os.Getenv("KUBERNETES_SERVICE_HOST") != "" {
+			return nil
+		}
+
+		return []Problem{{ProblemKubeconfigUnreadable, "KUBECONFIG", "", "export the path of the cluster kubeconfig"}}
+	}
+
+	// Like kubectl, KUBECONFIG may list several files to merge.
+	var problems []Problem
+
+	for _, path := range filepath.SplitList(kubeconfig) {
+		if path == "" {
+			continue
+		}
+
+		if _, err := clientcmd.LoadFromFile(path); err != nil {
+			problems = append(problems, Problem{ProblemKubeconfigUnreadable, "KUBECONFIG", path, err.Error()})
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,160 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/redhat-best-practices-for-k8s/certsuite-qe/tests/globalparameters"
+	"github.com/stretchr/testify/assert"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    server: https://127.0.0.1:6443
+  name: test
+contexts:
+- context:
+    cluster: test
+    user: test
+  name: test
+current-context: test
+users:
+- name: test
+  user:
+    token: test
+`
+
+func writeTestKubeconfig(t *testing.T, content string) string {
+	t.Helper()
+
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	assert.Nil(t, os.WriteFile(kubeconfig, []byte(content), 0600))
+
+	return kubeconfig
+}
+
+func problemKinds(err error) []ProblemKind {
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+
+	kinds := []ProblemKind{}
+	for _, problem := range validationErr.Problems {
+		kinds = append(kinds, problem.Kind)
+	}
+
+	return kinds
+}
+
+func TestValidate(t *testing.T) {
+	t.Setenv("KUBECONFIG", writeTestKubeconfig(t, testKubeconfig))
+
+	var c Config
+
+	c.General.Launcher = globalparameters.FakeLauncherName
+	c.General.UseBinary = "false"
+	c.General.DisableIntrusiveTests = "true"
+
+	assert.Nil(t, c.Validate())
+}
+
+func TestValidateProblems(t *testing.T) {
+	t.Setenv("KUBECONFIG", writeTestKubeconfig(t, "not: [a kubeconfig"))
+
+	var c Config
+
+	c.General.UseBinary = "yes please"
+	c.General.DebugCertsuite = "false"
+	c.General.ContainerEngine = "containerd"
+	c.General.DockerConfigDir = filepath.Join(t.TempDir(), "missing")
+	c.General.CertsuiteImage = "quay.io/redhat-best-practices-for-k8s/certsuite"
+	c.General.TimeoutMultiplier = -1
+
+	// UseBinary is invalid, the container launcher is selected.
+	err := c.Validate()
+	assert.Equal(t, []ProblemKind{ProblemInvalidBool, ProblemUnknownContainerEngine, ProblemMissingDockerConfig,
+		ProblemMissingImage, ProblemKubeconfigUnreadable, ProblemInvalidValue}, problemKinds(err))
+	assert.ErrorContains(t, err, "6 configuration problem(s)")
+	assert.ErrorContains(t, err, `invalid-bool: USE_BINARY="yes please", set true or false`)
+}
+
+func TestValidateBinaryLauncher(t *testing.T) {
+	t.Setenv("KUBECONFIG", "")
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+
+	var c Config
+
+	c.General.Launcher = globalparameters.BinaryLauncherName
+
+	assert.Equal(t, []ProblemKind{ProblemMissingBinary, ProblemKubeconfigUnreadable}, problemKinds(c.Validate()))
+
+	c.General.CertsuiteRepoPath = filepath.Join(t.TempDir(), "missing")
+	assert.ErrorContains(t, c.Validate(), "the certsuite clone does not exist")
+
+	// In a pod, the client uses the in-cluster configuration.
+	t.Setenv("KUBERNETES_SERVICE_HOST", "10.0.0.1")
+
+	c.General.CertsuiteRepoPath = t.TempDir()
+	assert.NotContains(t, problemKinds(c.Validate()), ProblemKubeconfigUnreadable)
+}
+
+func TestValidateBinaryLauncherPrebuilt(t *testing.T) {
+	t.Setenv("KUBECONFIG", writeTestKubeconfig(t, testKubeconfig))
+	// Without the go toolchain, only a binary built in the clone can be run.
+	t.Setenv("PATH", t.TempDir())
+
+	var c Config
+
+	c.General.Launcher = globalparameters.BinaryLauncherName
+	c.General.CertsuiteRepoPath = t.TempDir()
+	c.General.CertsuiteEntryPointBinary = "certsuite"
+
+	assert.ErrorContains(t, c.Validate(), "no certsuite binary is built in the clone")
+
+	assert.Nil(t, os.WriteFile(filepath.Join(c.General.CertsuiteRepoPath, "certsuite"), []byte("#!/bin/sh\n"), 0700))
+	assert.Nil(t, c.Validate())
+}
+
+func TestValidateJobLauncher(t *testing.T) {
+	t.Setenv("KUBECONFIG", writeTestKubeconfig(t, testKubeconfig))
+
+	var c Config
+
+	c.General.Launcher = globalparameters.JobLauncherName
+	c.General.DockerConfigDir = filepath.Join(t.TempDir(), "missing")
+	c.General.CertsuiteImage = "quay.io/redhat-best-practices-for-k8s/certsuite"
+
+	// The container engine is not used.
+	c.General.ContainerEngine = "containerd"
+
+	assert.Equal(t, []ProblemKind{ProblemMissingDockerConfig, ProblemMissingImage}, problemKinds(c.Validate()))
+
+	c.General.DockerConfigDir = t.TempDir()
+	c.General.CertsuiteImageTag = "latest"
+	assert.Nil(t, os.WriteFile(filepath.Join(c.General.DockerConfigDir, "config"), []byte("{}"), 0600))
+	assert.Nil(t, c.Validate())
+}
+
+func TestValidateKubeconfigList(t *testing.T) {
+	var c Config
+
+	c.General.Launcher = globalparameters.FakeLauncherName
+
+	kubeconfig := writeTestKubeconfig(t, testKubeconfig)
+	t.Setenv("KUBECONFIG", kubeconfig+string(filepath.ListSeparator)+writeTestKubeconfig(t, testKubeconfig))
+	assert.Nil(t, c.Validate())
+
+	unreadable := writeTestKubeconfig(t, "clusters: [")
+	t.Setenv("KUBECONFIG", kubeconfig+string(filepath.ListSeparator)+unreadable)
+
+	var validationErr *ValidationError
+
+	assert.ErrorAs(t, c.Validate(), &validationErr)
+	assert.Len(t, validationErr.Problems, 1)
+	assert.Equal(t, unreadable, validationErr.Problems[0].Value)
+}
@@ -0,0 +1,106 @@
+package job
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// DefineJob returns a suspended, non-retried job running a single container with the given command.
+func DefineJob(name, namespace, image string, command []string) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: batchv1.JobSpec{
+			Suspend:      ptr.To(true),
+			BackoffLimit: ptr.To[int32](0),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:                 corev1.RestartPolicyNever,
+					TerminationGracePeriodSeconds: ptr.To[int64](0),
+					Containers: []corev1.Container{
+						{
+							Name:    name,
+							Image:   image,
+							Command: command,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// RedefineWithServiceAccount sets the service account used by the job's pod.
+func RedefineWithServiceAccount(job *batchv1.Job, serviceAccountName string) {
+	job.Spec.Template.Spec.ServiceAccountName = serviceAccountName
+}
+
+// RedefineWithActiveDeadline sets the server side timeout of the job.
+func RedefineWithActiveDeadline(job *batchv1.Job, activeDeadlineSeconds int64) {
+	job.Spec.ActiveDeadlineSeconds = ptr.To(activeDeadlineSeconds)
+}
+
+// RedefineWithTTLAfterFinished lets the cluster garbage collect the job once it has finished.
+func RedefineWithTTLAfterFinished(job *batchv1.Job, ttlSeconds int32) {
+	job.Spec.TTLSecondsAfterFinished = ptr.To(ttlSeconds)
+}
+
+// RedefineWithEnv appends environment variables to all job containers.
+func RedefineWithEnv(job *batchv1.Job, env map[string]string) {
+	for index := range job.Spec.Template.Spec.Containers {
+		for name, value := range env {
+			job.Spec.Template.Spec.Containers[index].Env = append(job.Spec.Template.Spec.Containers[index].Env,
+				corev1.EnvVar{Name: name, Value: value})
+		}
+	}
+}
+
+// RedefineWithConfigMapVolume mounts the given config map into all job containers.
+func RedefineWithConfigMapVolume(job *batchv1.Job, configMapName, mountPath string) {
+	job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: configMapName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+			},
+		},
+	})
+
+	redefineWithVolumeMount(job, configMapName, mountPath)
+}
+
+// RedefineWithSecretVolume mounts the given secret into all job containers.
+func RedefineWithSecretVolume(job *batchv1.Job, volumeName, secretName, mountPath string) {
+	job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: secretName},
+		},
+	})
+
+	redefineWithVolumeMount(job, volumeName, mountPath)
+}
+
+// RedefineWithEmptyDirVolume mounts a scratch directory into all job containers.
+func RedefineWithEmptyDirVolume(job *batchv1.Job, volumeName, mountPath string) {
+	job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+
+	redefineWithVolumeMount(job, volumeName, mountPath)
+}
+
+func redefineWithVolumeMount(job *batchv1.Job, volumeName, mountPath string) {
+	for index := range job.Spec.Template.Spec.Containers {
+		job.Spec.Template.Spec.Containers[index].VolumeMounts = append(
+			job.Spec.Template.Spec.Containers[index].VolumeMounts,
+			corev1.VolumeMount{Name: volumeName, MountPath: mountPath})
+	}
+}
@@ -0,0 +1,42 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefineJob(t *testing.T) {
+	testJob := DefineJob("test-job", "test-ns", "test-image", []string{"/bin/sh", "-c", "true"})
+	assert.Equal(t, "test-job", testJob.Name)
+	assert.Equal(t, "test-ns", testJob.Namespace)
+	assert.True(t, *testJob.Spec.Suspend)
+	assert.Equal(t, int32(0), *testJob.Spec.BackoffLimit)
+	assert.Len(t, testJob.Spec.Template.Spec.Containers, 1)
+	assert.Equal(t, "test-image", testJob.Spec.Template.Spec.Containers[0].Image)
+}
+
+func TestRedefineJob(t *testing.T) {
+	testJob := DefineJob("test-job", "test-ns", "test-image", []string{"true"})
+
+	RedefineWithServiceAccount(testJob, "test-sa")
+	RedefineWithActiveDeadline(testJob, 60)
+	RedefineWithTTLAfterFinished(testJob, 30)
+	RedefineWithEnv(testJob, map[string]string{"KEY": "value"})
+	RedefineWithConfigMapVolume(testJob, "test-cm", "/config")
+	RedefineWithEmptyDirVolume(testJob, "results", "/results")
+	RedefineWithSecretVolume(testJob, "dockerconfig", "test-secret", "/dockerconfig")
+
+	podSpec := testJob.Spec.Template.Spec
+	assert.Equal(t, "test-sa", podSpec.ServiceAccountName)
+	assert.Equal(t, int64(60), *testJob.Spec.ActiveDeadlineSeconds)
+	assert.Equal(t, int32(30), *testJob.Spec.TTLSecondsAfterFinished)
+	assert.Equal(t, "KEY", podSpec.Containers[0].Env[0].Name)
+	assert.Len(t, podSpec.Volumes, 3)
+	assert.Equal(t, "test-cm", podSpec.Volumes[0].ConfigMap.Name)
+	assert.NotNil(t, podSpec.Volumes[1].EmptyDir)
+	assert.Equal(t, "test-secret", podSpec.Volumes[2].Secret.SecretName)
+	assert.Len(t, podSpec.Containers[0].VolumeMounts, 3)
+	assert.Equal(t, "dockerconfig", podSpec.Containers[0].VolumeMounts[2].Name)
+	assert.Equal(t, "/results", podSpec.Containers[0].VolumeMounts[1].MountPath)
+}